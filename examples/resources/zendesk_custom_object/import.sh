@@ -0,0 +1,5 @@
+# Custom objects are imported with their key
+terraform import zendesk_custom_object.asset asset
+
+# or with their title
+terraform import zendesk_custom_object.asset "title:Asset"
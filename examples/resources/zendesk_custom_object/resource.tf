@@ -0,0 +1,13 @@
+resource "zendesk_custom_object" "asset" {
+  key              = "asset"
+  title            = "Asset"
+  title_pluralized = "Assets"
+  description      = "Hardware lent to employees"
+}
+
+resource "zendesk_user_field" "asset" {
+  key                      = "asset"
+  type                     = "lookup"
+  name                     = "Asset"
+  relationship_target_type = "zen:custom_object:${zendesk_custom_object.asset.key}"
+}
@@ -0,0 +1,2 @@
+# Custom object fields are imported with the custom object key and the field ID
+terraform import zendesk_custom_object_field.contract asset/123
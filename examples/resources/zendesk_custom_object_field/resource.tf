@@ -0,0 +1,27 @@
+resource "zendesk_custom_object" "asset" {
+  key              = "asset"
+  title            = "Asset"
+  title_pluralized = "Assets"
+}
+
+resource "zendesk_custom_object" "contract" {
+  key              = "contract"
+  title            = "Contract"
+  title_pluralized = "Contracts"
+}
+
+resource "zendesk_custom_object_field" "serial_number" {
+  custom_object_key     = zendesk_custom_object.asset.key
+  key                   = "serial_number"
+  type                  = "regexp"
+  name                  = "Serial number"
+  regexp_for_validation = "^[A-Z0-9]{10}$"
+}
+
+resource "zendesk_custom_object_field" "contract" {
+  custom_object_key        = zendesk_custom_object.asset.key
+  key                      = "contract"
+  type                     = "lookup"
+  name                     = "Contract"
+  relationship_target_type = "zen:custom_object:${zendesk_custom_object.contract.key}"
+}
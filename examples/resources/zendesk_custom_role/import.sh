@@ -0,0 +1,5 @@
+# Custom roles are imported by ID
+terraform import zendesk_custom_role.tier_2 123
+
+# or by name
+terraform import zendesk_custom_role.tier_2 "name:Tier 2 agent"
@@ -0,0 +1,16 @@
+resource "zendesk_custom_role" "tier_2" {
+  name        = "Tier 2 agent"
+  description = "Agents handling escalated tickets"
+
+  # Permissions not set default to their most restrictive value
+  configuration = {
+    ticket_access            = "within-groups"
+    ticket_comment_access    = "public"
+    ticket_editing           = true
+    ticket_merge             = true
+    macro_access             = "manage-personal"
+    view_access              = "manage-personal"
+    end_user_profile_access  = "edit"
+    manage_group_memberships = true
+  }
+}
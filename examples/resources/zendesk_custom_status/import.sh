@@ -0,0 +1,5 @@
+# Custom statuses are imported by ID
+terraform import zendesk_custom_status.waiting_on_vendor 123
+
+# or by agent label
+terraform import zendesk_custom_status.waiting_on_vendor "agent_label:Waiting on vendor"
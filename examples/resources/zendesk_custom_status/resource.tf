@@ -0,0 +1,25 @@
+resource "zendesk_custom_status" "waiting_on_vendor" {
+  status_category      = "pending"
+  agent_label          = "Waiting on vendor"
+  end_user_label       = "Waiting on a partner"
+  description          = "The ticket is waiting on an answer from a vendor"
+  end_user_description = "We are waiting on an answer from one of our partners"
+}
+
+# Custom status IDs can be used in trigger and view conditions
+resource "zendesk_view" "waiting_on_vendor" {
+  title = "Waiting on vendor"
+  conditions = {
+    all = [
+      {
+        field    = "custom_status_id"
+        operator = "includes"
+        values   = [zendesk_custom_status.waiting_on_vendor.id]
+      }
+    ]
+  }
+
+  output = {
+    columns = ["status", "assignee"]
+  }
+}
@@ -0,0 +1,2 @@
+# Group memberships are imported with the group ID and the user ID
+terraform import zendesk_group_membership.agent_tier_2 123/456
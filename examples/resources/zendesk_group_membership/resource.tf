@@ -0,0 +1,15 @@
+resource "zendesk_group" "tier_2" {
+  name = "Tier 2"
+}
+
+resource "zendesk_user" "agent" {
+  name  = "Jane Doe"
+  email = "jane.doe@example.com"
+  role  = "agent"
+}
+
+resource "zendesk_group_membership" "agent_tier_2" {
+  group_id = zendesk_group.tier_2.id
+  user_id  = zendesk_user.agent.id
+  default  = true
+}
@@ -0,0 +1,2 @@
+# Group members are imported with the group ID
+terraform import zendesk_group_memberships.tier_2 123
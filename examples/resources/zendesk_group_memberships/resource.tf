@@ -0,0 +1,12 @@
+resource "zendesk_group" "tier_2" {
+  name = "Tier 2"
+}
+
+# Agents not listed are removed from the group
+resource "zendesk_group_memberships" "tier_2" {
+  group_id = zendesk_group.tier_2.id
+  user_ids = [
+    zendesk_user.jane.id,
+    zendesk_user.john.id,
+  ]
+}
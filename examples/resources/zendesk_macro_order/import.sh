@@ -0,0 +1,2 @@
+# The order of every macro is imported with the ID macro_order
+terraform import zendesk_macro_order.this macro_order
@@ -0,0 +1,8 @@
+# Other macros are shown after these ones
+resource "zendesk_macro_order" "this" {
+  macro_ids = [
+    zendesk_macro.close_and_redirect.id,
+    zendesk_macro.request_details.id,
+    zendesk_macro.escalate.id,
+  ]
+}
@@ -0,0 +1,5 @@
+# Organizations are imported by ID
+terraform import zendesk_organization.acme 123
+
+# or by external ID
+terraform import zendesk_organization.acme external_id:tenant-acme
@@ -0,0 +1,19 @@
+resource "zendesk_group" "enterprise" {
+  name = "Enterprise Support"
+}
+
+resource "zendesk_organization" "acme" {
+  name            = "Acme Inc"
+  external_id     = "tenant-acme"
+  domain_names    = ["acme.example.com"]
+  group_id        = zendesk_group.enterprise.id
+  shared_tickets  = true
+  shared_comments = true
+  tags            = ["enterprise"]
+  details         = "1 Main Street, Springfield"
+  notes           = "Renewal in Q3"
+
+  organization_fields = {
+    tier = "gold"
+  }
+}
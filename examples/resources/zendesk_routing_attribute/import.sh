@@ -0,0 +1,5 @@
+# Routing attributes are imported with their ID
+terraform import zendesk_routing_attribute.language 15821cba-7326-11e8-b07e-950ba849aa27
+
+# or with their name
+terraform import zendesk_routing_attribute.language "name:Language"
@@ -0,0 +1,3 @@
+resource "zendesk_routing_attribute" "language" {
+  name = "Language"
+}
@@ -0,0 +1,2 @@
+# Routing attribute values are imported with the attribute ID and the value ID
+terraform import zendesk_routing_attribute_value.premium 15821cba-7326-11e8-b07e-950ba849aa27/b376b35a-e38b-11e8-a292-e3b6377c5575
@@ -0,0 +1,18 @@
+resource "zendesk_routing_attribute" "product_tier" {
+  name = "Product tier"
+}
+
+resource "zendesk_routing_attribute_value" "premium" {
+  attribute_id = zendesk_routing_attribute.product_tier.id
+  name         = "Premium"
+  conditions = {
+    all = [
+      {
+        field           = "custom_field"
+        custom_field_id = zendesk_ticket_field.product.id
+        operator        = "is"
+        value           = "premium"
+      }
+    ]
+  }
+}
@@ -0,0 +1,5 @@
+# Routing queues are imported with their ID
+terraform import zendesk_routing_queue.urgent 01HG80ATNNZK1N7XRFVKX48XD6
+
+# or with their name
+terraform import zendesk_routing_queue.urgent "name:Urgent"
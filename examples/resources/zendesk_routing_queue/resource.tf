@@ -0,0 +1,24 @@
+resource "zendesk_group" "tier_1" {
+  name = "Tier 1"
+}
+
+resource "zendesk_group" "tier_2" {
+  name = "Tier 2"
+}
+
+resource "zendesk_routing_queue" "urgent" {
+  name             = "Urgent"
+  description      = "Urgent tickets"
+  priority         = 1
+  primary_groups   = [zendesk_group.tier_2.id]
+  secondary_groups = [zendesk_group.tier_1.id]
+  conditions = {
+    all = [
+      {
+        field    = "priority"
+        operator = "is"
+        value    = "urgent"
+      }
+    ]
+  }
+}
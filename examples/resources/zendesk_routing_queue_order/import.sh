@@ -0,0 +1,2 @@
+# The order of every routing queue is imported with the ID routing_queue_order
+terraform import zendesk_routing_queue_order.this routing_queue_order
@@ -0,0 +1,7 @@
+# Other queues are evaluated after these ones
+resource "zendesk_routing_queue_order" "this" {
+  queue_ids = [
+    zendesk_routing_queue.urgent.id,
+    zendesk_routing_queue.vip.id,
+  ]
+}
@@ -3,14 +3,17 @@ resource "zendesk_schedule" "test" {
   time_zone = "Pacific Time (US & Canada)"
 
   intervals = {
-    sunday = {
-      start_time = 5
-      end_time   = 13
-    }
-    tuesday = {
-      start_time = 5
-      end_time   = 14
-    }
+    sunday = [{
+      start_time = "05:00"
+      end_time   = "13:00"
+    }]
+    tuesday = [{
+      start_time = "08:00"
+      end_time   = "12:00"
+      }, {
+      start_time = "13:30"
+      end_time   = "17:30"
+    }]
   }
 }
 
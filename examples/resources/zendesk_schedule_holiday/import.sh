@@ -0,0 +1,2 @@
+# Schedule holidays are imported with the schedule ID and the holiday ID
+terraform import zendesk_schedule_holiday.christmas 123/456
@@ -0,0 +1,18 @@
+resource "zendesk_schedule" "support" {
+  name      = "Support"
+  time_zone = "Pacific Time (US & Canada)"
+
+  intervals = {
+    monday = [{
+      start_time = "09:00"
+      end_time   = "17:00"
+    }]
+  }
+}
+
+resource "zendesk_schedule_holiday" "christmas" {
+  schedule_id = zendesk_schedule.support.id
+  name        = "Christmas"
+  start_date  = "2025-12-25"
+  end_date    = "2025-12-26"
+}
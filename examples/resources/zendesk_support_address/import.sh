@@ -0,0 +1,5 @@
+# Support addresses are imported with their ID
+terraform import zendesk_support_address.acme 123
+
+# or with their email
+terraform import zendesk_support_address.acme "email:help@acme.com"
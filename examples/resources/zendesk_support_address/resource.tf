@@ -0,0 +1,14 @@
+resource "zendesk_brand" "acme" {
+  name      = "Acme"
+  subdomain = "acme"
+}
+
+resource "zendesk_support_address" "acme" {
+  email    = "help@acme.com"
+  name     = "Acme Support"
+  brand_id = zendesk_brand.acme.id
+
+  # the mail server of acme.com must forward help@acme.com to Zendesk
+  wait_for_verification = true
+  verification_timeout  = "15m"
+}
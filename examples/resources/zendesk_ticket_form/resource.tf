@@ -36,9 +36,17 @@ resource "zendesk_ticket_field" "test2" {
   ]
 }
 
+resource "zendesk_brand" "test" {
+  name      = var.title
+  subdomain = "testsubdomain123"
+}
+
 resource "zendesk_ticket_form" "test" {
   form_name = var.title
 
+  // Only show the form in the test brand
+  restricted_brand_ids = [zendesk_brand.test.id]
+
   ticket_field_ids = [
     // Default ticket field IDs for form
     37446012469780,
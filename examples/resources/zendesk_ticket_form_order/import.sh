@@ -0,0 +1,2 @@
+# The order of every ticket form is imported with the ID ticket_form_order
+terraform import zendesk_ticket_form_order.this ticket_form_order
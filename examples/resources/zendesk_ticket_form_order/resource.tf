@@ -0,0 +1,8 @@
+# Other ticket forms are shown after these ones
+resource "zendesk_ticket_form_order" "this" {
+  ticket_form_ids = [
+    zendesk_ticket_form.support.id,
+    zendesk_ticket_form.billing.id,
+    zendesk_ticket_form.returns.id,
+  ]
+}
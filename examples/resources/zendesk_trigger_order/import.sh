@@ -0,0 +1,2 @@
+# The order of every trigger category and trigger is imported with the ID trigger_order
+terraform import zendesk_trigger_order.this trigger_order
@@ -0,0 +1,18 @@
+# Categories and triggers not listed run after these ones
+resource "zendesk_trigger_order" "this" {
+  categories = [
+    {
+      category_id = zendesk_trigger_category.routing.id
+      trigger_ids = [
+        zendesk_trigger.vip_routing.id,
+        zendesk_trigger.default_routing.id,
+      ]
+    },
+    {
+      category_id = zendesk_trigger_category.notifications.id
+      trigger_ids = [
+        zendesk_trigger.notify_requester.id,
+      ]
+    },
+  ]
+}
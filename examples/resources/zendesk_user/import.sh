@@ -0,0 +1,5 @@
+# Users are imported by ID
+terraform import zendesk_user.jane 123
+
+# or by email
+terraform import zendesk_user.jane email:jane.doe@example.com
@@ -0,0 +1,20 @@
+resource "zendesk_group" "support" {
+  name = "Support"
+}
+
+resource "zendesk_user" "jane" {
+  name             = "Jane Doe"
+  email            = "jane.doe@example.com"
+  role             = "agent"
+  default_group_id = zendesk_group.support.id
+  time_zone        = "Eastern Time (US & Canada)"
+  locale           = "en-US"
+  tags             = ["tier_2"]
+
+  user_fields = {
+    team = "billing"
+  }
+
+  # suspend keeps the agent's history when the resource is destroyed
+  destroy_action = "suspend"
+}
@@ -0,0 +1,2 @@
+# The order of every view is imported with the ID view_order
+terraform import zendesk_view_order.this view_order
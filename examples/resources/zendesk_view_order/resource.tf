@@ -0,0 +1,8 @@
+# Other views are shown after these ones
+resource "zendesk_view_order" "this" {
+  view_ids = [
+    zendesk_view.open_tickets.id,
+    zendesk_view.pending_tickets.id,
+    zendesk_view.escalations.id,
+  ]
+}
@@ -0,0 +1,161 @@
+package models
+
+import (
+	"context"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ResourceTransform[zendeskapi.CustomObject] = &CustomObjectResourceModel{}
+
+// CustomObjectResourceModel is struct for custom object payload, the key is used as ID.
+// https://developer.zendesk.com/api-reference/custom-data/custom-objects/custom_objects/
+type CustomObjectResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	URL             types.String `tfsdk:"url"`
+	Key             types.String `tfsdk:"key"`
+	Title           types.String `tfsdk:"title"`
+	TitlePluralized types.String `tfsdk:"title_pluralized"`
+	Description     types.String `tfsdk:"description"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+}
+
+func (o *CustomObjectResourceModel) GetApiModelFromTfModel(_ context.Context) (object zendeskapi.CustomObject, diags diag.Diagnostics) {
+	object = zendeskapi.CustomObject{
+		Key:             o.Key.ValueString(),
+		Title:           o.Title.ValueString(),
+		TitlePluralized: o.TitlePluralized.ValueString(),
+		Description:     o.Description.ValueString(),
+	}
+
+	return object, diags
+}
+
+// GetTfModelFromApiModel uses the raw titles and description, so dynamic content
+// placeholders are kept as configured.
+func (o *CustomObjectResourceModel) GetTfModelFromApiModel(_ context.Context, object zendeskapi.CustomObject) (diags diag.Diagnostics) {
+	*o = CustomObjectResourceModel{
+		ID:              types.StringValue(object.Key),
+		URL:             types.StringValue(object.URL),
+		Key:             types.StringValue(object.Key),
+		Title:           types.StringValue(rawOrRendered(object.RawTitle, object.Title)),
+		TitlePluralized: types.StringValue(rawOrRendered(object.RawTitlePluralized, object.TitlePluralized)),
+		Description:     types.StringValue(rawOrRendered(object.RawDescription, object.Description)),
+		CreatedAt:       types.StringNull(),
+		UpdatedAt:       types.StringNull(),
+	}
+
+	if object.CreatedAt != nil {
+		o.CreatedAt = types.StringValue(object.CreatedAt.UTC().String())
+	}
+
+	if object.UpdatedAt != nil {
+		o.UpdatedAt = types.StringValue(object.UpdatedAt.UTC().String())
+	}
+
+	return diags
+}
+
+var _ ResourceTransformWithID[zendeskapi.CustomObjectField] = &CustomObjectFieldResourceModel{}
+
+// CustomObjectFieldResourceModel is struct for custom object field payload, it shares the
+// attributes of user and organization fields.
+// https://developer.zendesk.com/api-reference/custom-data/custom-objects/custom_object_fields/
+type CustomObjectFieldResourceModel struct {
+	CustomObjectKey types.String `tfsdk:"custom_object_key"`
+	UserOrgFieldResourceModel
+}
+
+func (f *CustomObjectFieldResourceModel) GetApiModelFromTfModel(ctx context.Context) (field zendeskapi.CustomObjectField, diags diag.Diagnostics) {
+	customFieldOptions, diags := getApiCustomFieldOptionsFromTf(ctx, f.CustomFieldOptions)
+
+	if diags.HasError() {
+		return field, diags
+	}
+
+	field = zendeskapi.CustomObjectField{
+		Key:                    f.Key.ValueString(),
+		Type:                   f.Type.ValueString(),
+		Title:                  f.Title.ValueString(),
+		Description:            f.Description.ValueString(),
+		Active:                 f.Active.ValueBool(),
+		RegexpForValidation:    f.RegexpForValidation.ValueString(),
+		Tag:                    f.Tag.ValueString(),
+		CustomFieldOptions:     customFieldOptions,
+		RelationshipTargetType: f.RelationshipTargetType.ValueString(),
+	}
+
+	if !f.RelationshipFilter.IsNull() && !f.RelationshipFilter.IsUnknown() {
+		relationshipFilter, diags := getApiRelationshipFilterFromTf(ctx, f.RelationshipFilter)
+
+		if diags.HasError() {
+			return field, diags
+		}
+
+		field.RelationshipFilter = &relationshipFilter
+	}
+
+	if !f.Position.IsUnknown() && !f.Position.IsNull() {
+		field.Position = f.Position.ValueInt64()
+	}
+
+	if f.Active.IsUnknown() || f.Active.IsNull() {
+		field.Active = true
+	}
+
+	return field, diags
+}
+
+// GetTfModelFromApiModel keeps the custom object key, which is only part of the request path.
+func (f *CustomObjectFieldResourceModel) GetTfModelFromApiModel(ctx context.Context, field zendeskapi.CustomObjectField) (diags diag.Diagnostics) {
+	tfCustomOptionsList, diags := getTfCustomFieldOptionsFromApi(ctx, field.CustomFieldOptions)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	tfRelationshipFilterObject := types.ObjectNull(RelationshipFilterResourceModel{}.AttributeTypes())
+
+	if field.RelationshipFilter != nil {
+		tfRelationshipFilterObject, diags = getTfRelationshipFilterFromApi(ctx, *field.RelationshipFilter)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	*f = CustomObjectFieldResourceModel{
+		CustomObjectKey: f.CustomObjectKey,
+		UserOrgFieldResourceModel: UserOrgFieldResourceModel{
+			ID:                     types.Int64Value(field.ID),
+			URL:                    types.StringValue(field.URL),
+			Key:                    types.StringValue(field.Key),
+			Type:                   types.StringValue(field.Type),
+			Title:                  types.StringValue(field.Title),
+			Description:            stringValueOrNull(field.Description),
+			Position:               types.Int64Value(field.Position),
+			Active:                 types.BoolValue(field.Active),
+			System:                 types.BoolValue(field.System),
+			RegexpForValidation:    stringValueOrNull(field.RegexpForValidation),
+			Tag:                    stringValueOrNull(field.Tag),
+			CustomFieldOptions:     tfCustomOptionsList,
+			CreatedAt:              types.StringNull(),
+			UpdatedAt:              types.StringNull(),
+			RelationshipTargetType: stringValueOrNull(field.RelationshipTargetType),
+			RelationshipFilter:     tfRelationshipFilterObject,
+		},
+	}
+
+	if field.CreatedAt != nil {
+		f.CreatedAt = types.StringValue(field.CreatedAt.UTC().String())
+	}
+
+	if field.UpdatedAt != nil {
+		f.UpdatedAt = types.StringValue(field.UpdatedAt.UTC().String())
+	}
+
+	return diags
+}
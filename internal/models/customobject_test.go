@@ -0,0 +1,205 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCustomObjectResourceModel_GetApiModelFromTfModel(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    CustomObjectResourceModel
+		expected zendeskapi.CustomObject
+	}{
+		{
+			testName: "should get a api model from a tf resource",
+			input: CustomObjectResourceModel{
+				Key:             types.StringValue("asset"),
+				Title:           types.StringValue("Asset"),
+				TitlePluralized: types.StringValue("Assets"),
+				Description:     types.StringValue(testDescription),
+			},
+			expected: zendeskapi.CustomObject{
+				Key:             "asset",
+				Title:           "Asset",
+				TitlePluralized: "Assets",
+				Description:     testDescription,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			out, _ := c.input.GetApiModelFromTfModel(t.Context())
+			if !reflect.DeepEqual(out, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, c.expected)
+			}
+		})
+	}
+}
+
+func TestCustomObjectResourceModel_GetTfModelFromApiModel(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    zendeskapi.CustomObject
+		expected CustomObjectResourceModel
+	}{
+		{
+			testName: "should generate TF resource model from api model, keeping dynamic content placeholders",
+			input: zendeskapi.CustomObject{
+				Key:             "asset",
+				URL:             testUrl,
+				Title:           "Asset",
+				RawTitle:        "{{dc.asset}}",
+				TitlePluralized: "Assets",
+				Description:     testDescription,
+				CreatedAt:       &testCreatedAt,
+				UpdatedAt:       &testUpdatedAt,
+			},
+			expected: CustomObjectResourceModel{
+				ID:              types.StringValue("asset"),
+				URL:             types.StringValue(testUrl),
+				Key:             types.StringValue("asset"),
+				Title:           types.StringValue("{{dc.asset}}"),
+				TitlePluralized: types.StringValue("Assets"),
+				Description:     types.StringValue(testDescription),
+				CreatedAt:       types.StringValue(testCreatedAt.UTC().String()),
+				UpdatedAt:       types.StringValue(testUpdatedAt.UTC().String()),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			var out CustomObjectResourceModel
+			out.GetTfModelFromApiModel(t.Context(), c.input)
+			if !reflect.DeepEqual(out, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, c.expected)
+			}
+		})
+	}
+}
+
+func testRelationshipFilterObject(t *testing.T) types.Object {
+	t.Helper()
+
+	filterObjectType := types.ObjectType{AttrTypes: RelationshipFilterObjectResourceModel{}.AttributeTypes()}
+
+	return types.ObjectValueMust(RelationshipFilterResourceModel{}.AttributeTypes(), map[string]attr.Value{
+		"all": types.ListValueMust(filterObjectType, []attr.Value{
+			types.ObjectValueMust(filterObjectType.AttrTypes, map[string]attr.Value{
+				"field":    types.StringValue("status"),
+				"operator": types.StringValue("is"),
+				"value":    types.StringValue("active"),
+			}),
+		}),
+		"any": types.ListNull(filterObjectType),
+	})
+}
+
+func TestCustomObjectFieldResourceModel_GetApiModelFromTfModel(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    CustomObjectFieldResourceModel
+		expected zendeskapi.CustomObjectField
+	}{
+		{
+			testName: "should get a api model from a tf resource",
+			input: CustomObjectFieldResourceModel{
+				CustomObjectKey: types.StringValue("asset"),
+				UserOrgFieldResourceModel: UserOrgFieldResourceModel{
+					Key:                    types.StringValue("contract"),
+					Type:                   types.StringValue("lookup"),
+					Title:                  types.StringValue("Contract"),
+					Position:               types.Int64Unknown(),
+					Active:                 types.BoolUnknown(),
+					CustomFieldOptions:     types.ListNull(types.ObjectType{AttrTypes: CustomFieldOptionResourceModel{}.AttributeTypes()}),
+					RelationshipTargetType: types.StringValue("zen:custom_object:contract"),
+					RelationshipFilter:     testRelationshipFilterObject(t),
+				},
+			},
+			expected: zendeskapi.CustomObjectField{
+				Key:                    "contract",
+				Type:                   "lookup",
+				Title:                  "Contract",
+				Active:                 true,
+				RelationshipTargetType: "zen:custom_object:contract",
+				RelationshipFilter: &zendesk.RelationshipFilter{
+					All: []zendesk.RelationshipFilterObject{{Field: "status", Operator: "is", Value: "active"}},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			out, _ := c.input.GetApiModelFromTfModel(t.Context())
+			if !reflect.DeepEqual(out, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, c.expected)
+			}
+		})
+	}
+}
+
+func TestCustomObjectFieldResourceModel_GetTfModelFromApiModel(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    zendeskapi.CustomObjectField
+		expected CustomObjectFieldResourceModel
+	}{
+		{
+			testName: "should generate TF resource model from api model, keeping the custom object key",
+			input: zendeskapi.CustomObjectField{
+				ID:                     testId,
+				URL:                    testUrl,
+				Key:                    "contract",
+				Type:                   "lookup",
+				Title:                  "Contract",
+				Position:               1,
+				Active:                 true,
+				RelationshipTargetType: "zen:custom_object:contract",
+				RelationshipFilter: &zendesk.RelationshipFilter{
+					All: []zendesk.RelationshipFilterObject{{Field: "status", Operator: "is", Value: "active"}},
+				},
+				CreatedAt: &testCreatedAt,
+				UpdatedAt: &testUpdatedAt,
+			},
+			expected: CustomObjectFieldResourceModel{
+				CustomObjectKey: types.StringValue("asset"),
+				UserOrgFieldResourceModel: UserOrgFieldResourceModel{
+					ID:                     types.Int64Value(testId),
+					URL:                    types.StringValue(testUrl),
+					Key:                    types.StringValue("contract"),
+					Type:                   types.StringValue("lookup"),
+					Title:                  types.StringValue("Contract"),
+					Description:            types.StringNull(),
+					Position:               types.Int64Value(1),
+					Active:                 types.BoolValue(true),
+					System:                 types.BoolValue(false),
+					RegexpForValidation:    types.StringNull(),
+					Tag:                    types.StringNull(),
+					CustomFieldOptions:     types.ListNull(types.ObjectType{AttrTypes: CustomFieldOptionResourceModel{}.AttributeTypes()}),
+					CreatedAt:              types.StringValue(testCreatedAt.UTC().String()),
+					UpdatedAt:              types.StringValue(testUpdatedAt.UTC().String()),
+					RelationshipTargetType: types.StringValue("zen:custom_object:contract"),
+					RelationshipFilter:     testRelationshipFilterObject(t),
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			out := CustomObjectFieldResourceModel{CustomObjectKey: types.StringValue("asset")}
+			out.GetTfModelFromApiModel(t.Context(), c.input)
+			if !reflect.DeepEqual(out, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, c.expected)
+			}
+		})
+	}
+}
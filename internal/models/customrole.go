@@ -0,0 +1,272 @@
+package models
+
+import (
+	"context"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// CustomRoleConfigurationModel holds the permissions of a custom role, custom object
+// permissions are not managed.
+type CustomRoleConfigurationModel struct {
+	AssignTicketsToAnyBrand      types.Bool   `tfsdk:"assign_tickets_to_any_brand"`
+	AssignTicketsToAnyGroup      types.Bool   `tfsdk:"assign_tickets_to_any_group"`
+	ChatAccess                   types.Bool   `tfsdk:"chat_access"`
+	EndUserListAccess            types.String `tfsdk:"end_user_list_access"`
+	EndUserProfileAccess         types.String `tfsdk:"end_user_profile_access"`
+	ExploreAccess                types.String `tfsdk:"explore_access"`
+	ForumAccess                  types.String `tfsdk:"forum_access"`
+	ForumAccessRestrictedContent types.Bool   `tfsdk:"forum_access_restricted_content"`
+	LightAgent                   types.Bool   `tfsdk:"light_agent"`
+	MacroAccess                  types.String `tfsdk:"macro_access"`
+	ManageAutomations            types.Bool   `tfsdk:"manage_automations"`
+	ManageBusinessRules          types.Bool   `tfsdk:"manage_business_rules"`
+	ManageContextualWorkspaces   types.Bool   `tfsdk:"manage_contextual_workspaces"`
+	ManageDynamicContent         types.Bool   `tfsdk:"manage_dynamic_content"`
+	ManageExtensionsAndChannels  types.Bool   `tfsdk:"manage_extensions_and_channels"`
+	ManageFacebook               types.Bool   `tfsdk:"manage_facebook"`
+	ManageGroupMemberships       types.Bool   `tfsdk:"manage_group_memberships"`
+	ManageGroups                 types.Bool   `tfsdk:"manage_groups"`
+	ManageOrganizationFields     types.Bool   `tfsdk:"manage_organization_fields"`
+	ManageOrganizations          types.Bool   `tfsdk:"manage_organizations"`
+	ManageRoles                  types.String `tfsdk:"manage_roles"`
+	ManageSkills                 types.Bool   `tfsdk:"manage_skills"`
+	ManageSlas                   types.Bool   `tfsdk:"manage_slas"`
+	ManageSuspendedTickets       types.Bool   `tfsdk:"manage_suspended_tickets"`
+	ManageTeamMembers            types.String `tfsdk:"manage_team_members"`
+	ManageTicketFields           types.Bool   `tfsdk:"manage_ticket_fields"`
+	ManageTicketForms            types.Bool   `tfsdk:"manage_ticket_forms"`
+	ManageTriggers               types.Bool   `tfsdk:"manage_triggers"`
+	ManageUserFields             types.Bool   `tfsdk:"manage_user_fields"`
+	OrganizationEditing          types.Bool   `tfsdk:"organization_editing"`
+	OrganizationNotesEditing     types.Bool   `tfsdk:"organization_notes_editing"`
+	ReportAccess                 types.String `tfsdk:"report_access"`
+	SideConversationCreate       types.Bool   `tfsdk:"side_conversation_create"`
+	TicketAccess                 types.String `tfsdk:"ticket_access"`
+	TicketCommentAccess          types.String `tfsdk:"ticket_comment_access"`
+	TicketDeletion               types.Bool   `tfsdk:"ticket_deletion"`
+	TicketEditing                types.Bool   `tfsdk:"ticket_editing"`
+	TicketMerge                  types.Bool   `tfsdk:"ticket_merge"`
+	TicketRedaction              types.Bool   `tfsdk:"ticket_redaction"`
+	TicketTagEditing             types.Bool   `tfsdk:"ticket_tag_editing"`
+	TwitterSearchAccess          types.Bool   `tfsdk:"twitter_search_access"`
+	ViewAccess                   types.String `tfsdk:"view_access"`
+	ViewDeletedTickets           types.Bool   `tfsdk:"view_deleted_tickets"`
+	VoiceAccess                  types.Bool   `tfsdk:"voice_access"`
+	VoiceDashboardAccess         types.Bool   `tfsdk:"voice_dashboard_access"`
+}
+
+func (c CustomRoleConfigurationModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"assign_tickets_to_any_brand":     types.BoolType,
+		"assign_tickets_to_any_group":     types.BoolType,
+		"chat_access":                     types.BoolType,
+		"end_user_list_access":            types.StringType,
+		"end_user_profile_access":         types.StringType,
+		"explore_access":                  types.StringType,
+		"forum_access":                    types.StringType,
+		"forum_access_restricted_content": types.BoolType,
+		"light_agent":                     types.BoolType,
+		"macro_access":                    types.StringType,
+		"manage_automations":              types.BoolType,
+		"manage_business_rules":           types.BoolType,
+		"manage_contextual_workspaces":    types.BoolType,
+		"manage_dynamic_content":          types.BoolType,
+		"manage_extensions_and_channels":  types.BoolType,
+		"manage_facebook":                 types.BoolType,
+		"manage_group_memberships":        types.BoolType,
+		"manage_groups":                   types.BoolType,
+		"manage_organization_fields":      types.BoolType,
+		"manage_organizations":            types.BoolType,
+		"manage_roles":                    types.StringType,
+		"manage_skills":                   types.BoolType,
+		"manage_slas":                     types.BoolType,
+		"manage_suspended_tickets":        types.BoolType,
+		"manage_team_members":             types.StringType,
+		"manage_ticket_fields":            types.BoolType,
+		"manage_ticket_forms":             types.BoolType,
+		"manage_triggers":                 types.BoolType,
+		"manage_user_fields":              types.BoolType,
+		"organization_editing":            types.BoolType,
+		"organization_notes_editing":      types.BoolType,
+		"report_access":                   types.StringType,
+		"side_conversation_create":        types.BoolType,
+		"ticket_access":                   types.StringType,
+		"ticket_comment_access":           types.StringType,
+		"ticket_deletion":                 types.BoolType,
+		"ticket_editing":                  types.BoolType,
+		"ticket_merge":                    types.BoolType,
+		"ticket_redaction":                types.BoolType,
+		"ticket_tag_editing":              types.BoolType,
+		"twitter_search_access":           types.BoolType,
+		"view_access":                     types.StringType,
+		"view_deleted_tickets":            types.BoolType,
+		"voice_access":                    types.BoolType,
+		"voice_dashboard_access":          types.BoolType,
+	}
+}
+
+func (c CustomRoleConfigurationModel) getApiConfiguration() zendesk.Configuration {
+	return zendesk.Configuration{
+		AssignTicketsToAnyBrand:      c.AssignTicketsToAnyBrand.ValueBool(),
+		AssignTicketsToAnyGroup:      c.AssignTicketsToAnyGroup.ValueBool(),
+		ChatAccess:                   c.ChatAccess.ValueBool(),
+		EndUserListAccess:            c.EndUserListAccess.ValueString(),
+		EndUserProfileAccess:         c.EndUserProfileAccess.ValueString(),
+		ExploreAccess:                c.ExploreAccess.ValueString(),
+		ForumAccess:                  c.ForumAccess.ValueString(),
+		ForumAccessRestrictedContent: c.ForumAccessRestrictedContent.ValueBool(),
+		LightAgent:                   c.LightAgent.ValueBool(),
+		MacroAccess:                  c.MacroAccess.ValueString(),
+		ManageAutomations:            c.ManageAutomations.ValueBool(),
+		ManageBusinessRules:          c.ManageBusinessRules.ValueBool(),
+		ManageContextualWorkspaces:   c.ManageContextualWorkspaces.ValueBool(),
+		ManageDynamicContent:         c.ManageDynamicContent.ValueBool(),
+		ManageExtensionsAndChannels:  c.ManageExtensionsAndChannels.ValueBool(),
+		ManageFacebook:               c.ManageFacebook.ValueBool(),
+		ManageGroupMemberships:       c.ManageGroupMemberships.ValueBool(),
+		ManageGroups:                 c.ManageGroups.ValueBool(),
+		ManageOrganizationFields:     c.ManageOrganizationFields.ValueBool(),
+		ManageOrganizations:          c.ManageOrganizations.ValueBool(),
+		ManageRoles:                  c.ManageRoles.ValueString(),
+		ManageSkills:                 c.ManageSkills.ValueBool(),
+		ManageSlas:                   c.ManageSlas.ValueBool(),
+		ManageSuspendedTickets:       c.ManageSuspendedTickets.ValueBool(),
+		ManageTeamMembers:            c.ManageTeamMembers.ValueString(),
+		ManageTicketFields:           c.ManageTicketFields.ValueBool(),
+		ManageTicketForms:            c.ManageTicketForms.ValueBool(),
+		ManageTriggers:               c.ManageTriggers.ValueBool(),
+		ManageUserFields:             c.ManageUserFields.ValueBool(),
+		OrganizationEditing:          c.OrganizationEditing.ValueBool(),
+		OrganizationNotesEditing:     c.OrganizationNotesEditing.ValueBool(),
+		ReportAccess:                 c.ReportAccess.ValueString(),
+		SideConversationCreate:       c.SideConversationCreate.ValueBool(),
+		TicketAccess:                 c.TicketAccess.ValueString(),
+		TicketCommentAccess:          c.TicketCommentAccess.ValueString(),
+		TicketDeletion:               c.TicketDeletion.ValueBool(),
+		TicketEditing:                c.TicketEditing.ValueBool(),
+		TicketMerge:                  c.TicketMerge.ValueBool(),
+		TicketRedaction:              c.TicketRedaction.ValueBool(),
+		TicketTagEditing:             c.TicketTagEditing.ValueBool(),
+		TwitterSearchAccess:          c.TwitterSearchAccess.ValueBool(),
+		ViewAccess:                   c.ViewAccess.ValueString(),
+		ViewDeletedTickets:           c.ViewDeletedTickets.ValueBool(),
+		VoiceAccess:                  c.VoiceAccess.ValueBool(),
+		VoiceDashboardAccess:         c.VoiceDashboardAccess.ValueBool(),
+	}
+}
+
+func getTfCustomRoleConfiguration(configuration zendesk.Configuration) CustomRoleConfigurationModel {
+	return CustomRoleConfigurationModel{
+		AssignTicketsToAnyBrand:      types.BoolValue(configuration.AssignTicketsToAnyBrand),
+		AssignTicketsToAnyGroup:      types.BoolValue(configuration.AssignTicketsToAnyGroup),
+		ChatAccess:                   types.BoolValue(configuration.ChatAccess),
+		EndUserListAccess:            types.StringValue(configuration.EndUserListAccess),
+		EndUserProfileAccess:         types.StringValue(configuration.EndUserProfileAccess),
+		ExploreAccess:                types.StringValue(configuration.ExploreAccess),
+		ForumAccess:                  types.StringValue(configuration.ForumAccess),
+		ForumAccessRestrictedContent: types.BoolValue(configuration.ForumAccessRestrictedContent),
+		LightAgent:                   types.BoolValue(configuration.LightAgent),
+		MacroAccess:                  types.StringValue(configuration.MacroAccess),
+		ManageAutomations:            types.BoolValue(configuration.ManageAutomations),
+		ManageBusinessRules:          types.BoolValue(configuration.ManageBusinessRules),
+		ManageContextualWorkspaces:   types.BoolValue(configuration.ManageContextualWorkspaces),
+		ManageDynamicContent:         types.BoolValue(configuration.ManageDynamicContent),
+		ManageExtensionsAndChannels:  types.BoolValue(configuration.ManageExtensionsAndChannels),
+		ManageFacebook:               types.BoolValue(configuration.ManageFacebook),
+		ManageGroupMemberships:       types.BoolValue(configuration.ManageGroupMemberships),
+		ManageGroups:                 types.BoolValue(configuration.ManageGroups),
+		ManageOrganizationFields:     types.BoolValue(configuration.ManageOrganizationFields),
+		ManageOrganizations:          types.BoolValue(configuration.ManageOrganizations),
+		ManageRoles:                  types.StringValue(configuration.ManageRoles),
+		ManageSkills:                 types.BoolValue(configuration.ManageSkills),
+		ManageSlas:                   types.BoolValue(configuration.ManageSlas),
+		ManageSuspendedTickets:       types.BoolValue(configuration.ManageSuspendedTickets),
+		ManageTeamMembers:            types.StringValue(configuration.ManageTeamMembers),
+		ManageTicketFields:           types.BoolValue(configuration.ManageTicketFields),
+		ManageTicketForms:            types.BoolValue(configuration.ManageTicketForms),
+		ManageTriggers:               types.BoolValue(configuration.ManageTriggers),
+		ManageUserFields:             types.BoolValue(configuration.ManageUserFields),
+		OrganizationEditing:          types.BoolValue(configuration.OrganizationEditing),
+		OrganizationNotesEditing:     types.BoolValue(configuration.OrganizationNotesEditing),
+		ReportAccess:                 types.StringValue(configuration.ReportAccess),
+		SideConversationCreate:       types.BoolValue(configuration.SideConversationCreate),
+		TicketAccess:                 types.StringValue(configuration.TicketAccess),
+		TicketCommentAccess:          types.StringValue(configuration.TicketCommentAccess),
+		TicketDeletion:               types.BoolValue(configuration.TicketDeletion),
+		TicketEditing:                types.BoolValue(configuration.TicketEditing),
+		TicketMerge:                  types.BoolValue(configuration.TicketMerge),
+		TicketRedaction:              types.BoolValue(configuration.TicketRedaction),
+		TicketTagEditing:             types.BoolValue(configuration.TicketTagEditing),
+		TwitterSearchAccess:          types.BoolValue(configuration.TwitterSearchAccess),
+		ViewAccess:                   types.StringValue(configuration.ViewAccess),
+		ViewDeletedTickets:           types.BoolValue(configuration.ViewDeletedTickets),
+		VoiceAccess:                  types.BoolValue(configuration.VoiceAccess),
+		VoiceDashboardAccess:         types.BoolValue(configuration.VoiceDashboardAccess),
+	}
+}
+
+var _ ResourceTransformWithID[zendesk.CustomRole] = &CustomRoleResourceModel{}
+
+// CustomRoleResourceModel is struct for custom agent role payload
+// https://developer.zendesk.com/api-reference/ticketing/account-configuration/custom_roles/
+type CustomRoleResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	RoleType        types.Int64  `tfsdk:"role_type"`
+	Configuration   types.Object `tfsdk:"configuration"`
+	TeamMemberCount types.Int64  `tfsdk:"team_member_count"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+}
+
+func (r *CustomRoleResourceModel) GetID() int64 {
+	return r.ID.ValueInt64()
+}
+
+func (r *CustomRoleResourceModel) GetApiModelFromTfModel(ctx context.Context) (role zendesk.CustomRole, diags diag.Diagnostics) {
+	var configuration CustomRoleConfigurationModel
+
+	diags.Append(r.Configuration.As(ctx, &configuration, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})...)
+
+	if diags.HasError() {
+		return role, diags
+	}
+
+	role = zendesk.CustomRole{
+		Name:          r.Name.ValueString(),
+		Description:   r.Description.ValueString(),
+		RoleType:      r.RoleType.ValueInt64(),
+		Configuration: configuration.getApiConfiguration(),
+	}
+
+	return role, diags
+}
+
+func (r *CustomRoleResourceModel) GetTfModelFromApiModel(ctx context.Context, role zendesk.CustomRole) (diags diag.Diagnostics) {
+	configuration := getTfCustomRoleConfiguration(role.Configuration)
+
+	configurationObject, diags := types.ObjectValueFrom(ctx, configuration.AttributeTypes(), configuration)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	*r = CustomRoleResourceModel{
+		ID:              types.Int64Value(role.ID),
+		Name:            types.StringValue(role.Name),
+		Description:     types.StringValue(role.Description),
+		RoleType:        types.Int64Value(role.RoleType),
+		Configuration:   configurationObject,
+		TeamMemberCount: types.Int64Value(role.TeamMemberCount),
+		CreatedAt:       types.StringValue(role.CreatedAt.UTC().String()),
+		UpdatedAt:       types.StringValue(role.UpdatedAt.UTC().String()),
+	}
+
+	return diags
+}
@@ -0,0 +1,109 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var testCustomRoleConfiguration = zendesk.Configuration{
+	TicketAccess:        "within-groups",
+	TicketCommentAccess: "public",
+	TicketEditing:       true,
+	ManageGroups:        true,
+	ManageTeamMembers:   "readonly",
+}
+
+func testCustomRoleConfigurationObject(t *testing.T) types.Object {
+	t.Helper()
+
+	configuration := getTfCustomRoleConfiguration(testCustomRoleConfiguration)
+
+	object, diags := types.ObjectValueFrom(t.Context(), configuration.AttributeTypes(), configuration)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags.Errors())
+	}
+
+	return object
+}
+
+func TestCustomRoleResourceModel_GetApiModelFromTfModel(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    CustomRoleResourceModel
+		expected zendesk.CustomRole
+	}{
+		{
+			testName: "should get a api model from a tf resource",
+			input: CustomRoleResourceModel{
+				Name:          types.StringValue(testTitle),
+				Description:   types.StringValue(testDescription),
+				RoleType:      types.Int64Value(1),
+				Configuration: testCustomRoleConfigurationObject(t),
+			},
+			expected: zendesk.CustomRole{
+				Name:          testTitle,
+				Description:   testDescription,
+				RoleType:      1,
+				Configuration: testCustomRoleConfiguration,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			out, diags := c.input.GetApiModelFromTfModel(t.Context())
+			if diags.HasError() {
+				t.Fatalf("GetApiModelFromTfModel() got error: %v", diags.Errors())
+			}
+			if !reflect.DeepEqual(out, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, c.expected)
+			}
+		})
+	}
+}
+
+func TestCustomRoleResourceModel_GetTfModelFromApiModel(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    zendesk.CustomRole
+		expected CustomRoleResourceModel
+	}{
+		{
+			testName: "should generate TF resource model from api model",
+			input: zendesk.CustomRole{
+				ID:              testId,
+				Name:            testTitle,
+				Description:     testDescription,
+				TeamMemberCount: 3,
+				Configuration:   testCustomRoleConfiguration,
+				CreatedAt:       testCreatedAt,
+				UpdatedAt:       testUpdatedAt,
+			},
+			expected: CustomRoleResourceModel{
+				ID:              types.Int64Value(testId),
+				Name:            types.StringValue(testTitle),
+				Description:     types.StringValue(testDescription),
+				RoleType:        types.Int64Value(0),
+				Configuration:   testCustomRoleConfigurationObject(t),
+				TeamMemberCount: types.Int64Value(3),
+				CreatedAt:       types.StringValue(testCreatedAt.UTC().String()),
+				UpdatedAt:       types.StringValue(testUpdatedAt.UTC().String()),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			var out CustomRoleResourceModel
+			if diags := out.GetTfModelFromApiModel(t.Context(), c.input); diags.HasError() {
+				t.Fatalf("GetTfModelFromApiModel() got error: %v", diags.Errors())
+			}
+			if !reflect.DeepEqual(out, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, c.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"context"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ResourceTransformWithID[zendeskapi.CustomStatus] = &CustomStatusResourceModel{}
+
+// CustomStatusResourceModel is struct for custom ticket status payload
+// https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/
+type CustomStatusResourceModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	StatusCategory     types.String `tfsdk:"status_category"`
+	AgentLabel         types.String `tfsdk:"agent_label"`
+	EndUserLabel       types.String `tfsdk:"end_user_label"`
+	Description        types.String `tfsdk:"description"`
+	EndUserDescription types.String `tfsdk:"end_user_description"`
+	Active             types.Bool   `tfsdk:"active"`
+	Default            types.Bool   `tfsdk:"default"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+}
+
+func (s *CustomStatusResourceModel) GetID() int64 {
+	return s.ID.ValueInt64()
+}
+
+func (s *CustomStatusResourceModel) GetApiModelFromTfModel(_ context.Context) (status zendeskapi.CustomStatus, diags diag.Diagnostics) {
+	status = zendeskapi.CustomStatus{
+		StatusCategory:     s.StatusCategory.ValueString(),
+		AgentLabel:         s.AgentLabel.ValueString(),
+		EndUserLabel:       s.EndUserLabel.ValueString(),
+		Description:        s.Description.ValueString(),
+		EndUserDescription: s.EndUserDescription.ValueString(),
+		Active:             s.Active.ValueBool(),
+	}
+
+	return status, diags
+}
+
+// GetTfModelFromApiModel uses the raw labels and descriptions, so dynamic content
+// placeholders are kept as configured.
+func (s *CustomStatusResourceModel) GetTfModelFromApiModel(_ context.Context, status zendeskapi.CustomStatus) (diags diag.Diagnostics) {
+	*s = CustomStatusResourceModel{
+		ID:                 types.Int64Value(status.ID),
+		StatusCategory:     types.StringValue(status.StatusCategory),
+		AgentLabel:         types.StringValue(rawOrRendered(status.RawAgentLabel, status.AgentLabel)),
+		EndUserLabel:       types.StringValue(rawOrRendered(status.RawEndUserLabel, status.EndUserLabel)),
+		Description:        types.StringValue(rawOrRendered(status.RawDescription, status.Description)),
+		EndUserDescription: types.StringValue(rawOrRendered(status.RawEndUserDescription, status.EndUserDescription)),
+		Active:             types.BoolValue(status.Active),
+		Default:            types.BoolValue(status.Default),
+		CreatedAt:          types.StringNull(),
+		UpdatedAt:          types.StringNull(),
+	}
+
+	if status.CreatedAt != nil {
+		s.CreatedAt = types.StringValue(status.CreatedAt.UTC().String())
+	}
+
+	if status.UpdatedAt != nil {
+		s.UpdatedAt = types.StringValue(status.UpdatedAt.UTC().String())
+	}
+
+	return diags
+}
+
+func rawOrRendered(raw string, rendered string) string {
+	if raw != "" {
+		return raw
+	}
+
+	return rendered
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCustomStatusResourceModel_GetApiModelFromTfModel(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    CustomStatusResourceModel
+		expected zendeskapi.CustomStatus
+	}{
+		{
+			testName: "should get a api model from a tf resource",
+			input: CustomStatusResourceModel{
+				StatusCategory:     types.StringValue("pending"),
+				AgentLabel:         types.StringValue(testTitle),
+				EndUserLabel:       types.StringUnknown(),
+				Description:        types.StringValue(testDescription),
+				EndUserDescription: types.StringValue(""),
+				Active:             types.BoolValue(true),
+			},
+			expected: zendeskapi.CustomStatus{
+				StatusCategory: "pending",
+				AgentLabel:     testTitle,
+				Description:    testDescription,
+				Active:         true,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			out, _ := c.input.GetApiModelFromTfModel(t.Context())
+			if !reflect.DeepEqual(out, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, c.expected)
+			}
+		})
+	}
+}
+
+func TestCustomStatusResourceModel_GetTfModelFromApiModel(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    zendeskapi.CustomStatus
+		expected CustomStatusResourceModel
+	}{
+		{
+			testName: "should generate TF resource model from api model, keeping dynamic content placeholders",
+			input: zendeskapi.CustomStatus{
+				ID:                 testId,
+				StatusCategory:     "hold",
+				AgentLabel:         "Waiting on vendor",
+				RawAgentLabel:      "{{dc.waiting_on_vendor}}",
+				EndUserLabel:       "Waiting",
+				Description:        testDescription,
+				EndUserDescription: "",
+				Active:             true,
+				CreatedAt:          &testCreatedAt,
+				UpdatedAt:          &testUpdatedAt,
+			},
+			expected: CustomStatusResourceModel{
+				ID:                 types.Int64Value(testId),
+				StatusCategory:     types.StringValue("hold"),
+				AgentLabel:         types.StringValue("{{dc.waiting_on_vendor}}"),
+				EndUserLabel:       types.StringValue("Waiting"),
+				Description:        types.StringValue(testDescription),
+				EndUserDescription: types.StringValue(""),
+				Active:             types.BoolValue(true),
+				Default:            types.BoolValue(false),
+				CreatedAt:          types.StringValue(testCreatedAt.UTC().String()),
+				UpdatedAt:          types.StringValue(testUpdatedAt.UTC().String()),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			var out CustomStatusResourceModel
+			out.GetTfModelFromApiModel(t.Context(), c.input)
+			if !reflect.DeepEqual(out, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, c.expected)
+			}
+		})
+	}
+}
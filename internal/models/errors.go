@@ -0,0 +1,149 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/JacobPotter/go-zendesk/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// AttributeSchema is satisfied by the resource schema held in a plan, state or config,
+// used to check whether a Zendesk error detail refers to one of its attributes.
+type AttributeSchema interface {
+	TypeAtPath(context.Context, path.Path) (attr.Type, diag.Diagnostics)
+}
+
+// apiErrorEnvelope is the error body returned by the Zendesk API, ex:
+// {"error":"RecordInvalid","description":"Record validation errors","details":{"title":[{"description":"Title: cannot be blank"}]}}
+//
+// Some endpoints, ex: webhooks, return a list of errors instead.
+type apiErrorEnvelope struct {
+	Error       json.RawMessage                 `json:"error"`
+	Description string                          `json:"description"`
+	Details     map[string][]apiErrorDetailItem `json:"details"`
+	Errors      []apiErrorItem                  `json:"errors"`
+}
+
+type apiErrorDetailItem struct {
+	Description string `json:"description"`
+	Error       string `json:"error"`
+}
+
+type apiErrorItem struct {
+	Code   string `json:"code"`
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+}
+
+// IsNotFound reports whether err is a Zendesk API 404 response, meaning the
+// resource no longer exists.
+func IsNotFound(err error) bool {
+	var zdErr client.Error
+	if errors.As(err, &zdErr) && zdErr.Resp != nil {
+		return zdErr.Status() == http.StatusNotFound
+	}
+	return false
+}
+
+// APIErrorDiagnostics converts err into diagnostics with the given summary. Zendesk API
+// errors get one diagnostic per validation detail, attached to the matching attribute of
+// resourceSchema when there is one. Other errors get a single diagnostic.
+func APIErrorDiagnostics(ctx context.Context, summary string, err error, resourceSchema AttributeSchema) (diags diag.Diagnostics) {
+	var zdErr client.Error
+	if !errors.As(err, &zdErr) || zdErr.Resp == nil {
+		diags.AddError(summary, fmt.Sprintf("Error: %s", err))
+		return diags
+	}
+
+	var envelope apiErrorEnvelope
+	if jsonErr := json.Unmarshal(zdErr.ErrorBody, &envelope); jsonErr != nil {
+		diags.AddError(summary, fmt.Sprintf("Error: %s", err))
+		return diags
+	}
+
+	code, message := envelope.errorCode()
+
+	prefix := fmt.Sprintf("Error: %d", zdErr.Status())
+	if code != "" {
+		prefix += " " + code
+	}
+
+	keys := make([]string, 0, len(envelope.Details))
+	for key := range envelope.Details {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		for _, detail := range envelope.Details[key] {
+			description := detail.Description
+			if description == "" {
+				description = fmt.Sprintf("%s: %s", key, detail.Error)
+			}
+
+			detailMsg := fmt.Sprintf("%s: %s", prefix, description)
+
+			if hasAttribute(ctx, resourceSchema, key) {
+				diags.AddAttributeError(path.Root(key), summary, detailMsg)
+			} else {
+				diags.AddError(summary, detailMsg)
+			}
+		}
+	}
+
+	for _, item := range envelope.Errors {
+		description := item.Detail
+		if description == "" {
+			description = item.Title
+		}
+		diags.AddError(summary, fmt.Sprintf("Error: %d %s: %s", zdErr.Status(), item.Code, description))
+	}
+
+	if !diags.HasError() {
+		description := envelope.Description
+		if description == "" {
+			description = message
+		}
+		if description == "" {
+			description = string(zdErr.ErrorBody)
+		}
+		diags.AddError(summary, fmt.Sprintf("%s: %s", prefix, description))
+	}
+
+	return diags
+}
+
+// errorCode returns the error code of the envelope, given either as a string or as an
+// object with a title and a message.
+func (e apiErrorEnvelope) errorCode() (code string, message string) {
+	if err := json.Unmarshal(e.Error, &code); err == nil {
+		return code, ""
+	}
+
+	var codeObject struct {
+		Title   string `json:"title"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(e.Error, &codeObject); err == nil {
+		return codeObject.Title, codeObject.Message
+	}
+
+	return "", ""
+}
+
+func hasAttribute(ctx context.Context, resourceSchema AttributeSchema, key string) bool {
+	if resourceSchema == nil || key == "" {
+		return false
+	}
+
+	_, diags := resourceSchema.TypeAtPath(ctx, path.Root(key))
+
+	return !diags.HasError()
+}
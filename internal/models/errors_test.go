@@ -0,0 +1,138 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testAttributeSchema is an AttributeSchema with the given root string attributes.
+type testAttributeSchema []string
+
+func (s testAttributeSchema) TypeAtPath(_ context.Context, p path.Path) (attr.Type, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	for _, name := range s {
+		if p.Equal(path.Root(name)) {
+			return types.StringType, diags
+		}
+	}
+	diags.AddAttributeError(p, "Invalid Schema Path", "no attribute at path")
+	return nil, diags
+}
+
+func TestIsNotFound(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    error
+		expected bool
+	}{
+		{
+			testName: "should detect not found error",
+			input:    client.NewError([]byte(`{"error":"RecordNotFound"}`), &http.Response{StatusCode: http.StatusNotFound}),
+			expected: true,
+		},
+		{
+			testName: "should detect wrapped not found error",
+			input:    fmt.Errorf("wrapped: %w", client.NewError(nil, &http.Response{StatusCode: http.StatusNotFound})),
+			expected: true,
+		},
+		{
+			testName: "should ignore other api errors",
+			input:    client.NewError(nil, &http.Response{StatusCode: http.StatusUnprocessableEntity}),
+			expected: false,
+		},
+		{
+			testName: "should ignore non api errors",
+			input:    errors.New("404"),
+			expected: false,
+		},
+		{
+			testName: "should ignore nil error",
+			input:    nil,
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			if out := IsNotFound(c.input); out != c.expected {
+				t.Fatalf(errorOutputMismatch, c.testName, out, c.expected)
+			}
+		})
+	}
+}
+
+func TestAPIErrorDiagnostics(t *testing.T) {
+	unprocessable := &http.Response{StatusCode: http.StatusUnprocessableEntity}
+	resourceSchema := testAttributeSchema{"title", "actions"}
+
+	cases := []struct {
+		testName string
+		input    error
+		expected diag.Diagnostics
+	}{
+		{
+			testName: "should map details to attributes",
+			input: client.NewError([]byte(`{"error":"RecordInvalid","description":"Record validation errors","details":{`+
+				`"title":[{"description":"Title: cannot be blank","error":"BlankValue"}],`+
+				`"base":[{"description":"Invalid conditions"}]}}`), unprocessable),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error creating resource", "Error: 422 RecordInvalid: Invalid conditions"),
+				diag.NewAttributeErrorDiagnostic(path.Root("title"), "Error creating resource", "Error: 422 RecordInvalid: Title: cannot be blank"),
+			},
+		},
+		{
+			testName: "should use envelope description without details",
+			input:    client.NewError([]byte(`{"error":"InvalidEndpoint","description":"Not found"}`), &http.Response{StatusCode: http.StatusNotFound}),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error creating resource", "Error: 404 InvalidEndpoint: Not found"),
+			},
+		},
+		{
+			testName: "should handle error object",
+			input:    client.NewError([]byte(`{"error":{"title":"Forbidden","message":"You do not have access"}}`), &http.Response{StatusCode: http.StatusForbidden}),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error creating resource", "Error: 403 Forbidden: You do not have access"),
+			},
+		},
+		{
+			testName: "should handle error lists",
+			input:    client.NewError([]byte(`{"errors":[{"code":"InvalidValue","title":"Invalid endpoint","detail":"endpoint must be https"}]}`), unprocessable),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error creating resource", "Error: 422 InvalidValue: endpoint must be https"),
+			},
+		},
+		{
+			testName: "should fall back to raw error for non JSON bodies",
+			input:    client.NewError([]byte("Bad Gateway"), &http.Response{StatusCode: http.StatusBadGateway}),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error creating resource", "Error: 502: Bad Gateway"),
+			},
+		},
+		{
+			testName: "should fall back to raw error for non api errors",
+			input:    errors.New("connection refused"),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error creating resource", "Error: connection refused"),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			out := APIErrorDiagnostics(t.Context(), "Error creating resource", c.input, resourceSchema)
+			if !reflect.DeepEqual(out, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, c.expected)
+			}
+		})
+	}
+}
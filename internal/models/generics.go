@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"slices"
 	"strconv"
+	"strings"
 )
 
 type ResourceTransform[M any] interface {
@@ -38,7 +41,7 @@ func CreateResource[M any](ctx context.Context, request resource.CreateRequest,
 	resp, err := createFunc(ctx, newResource)
 
 	if err != nil {
-		response.Diagnostics.AddError("Error creating resource", fmt.Sprintf("Error: %s", err))
+		response.Diagnostics.Append(APIErrorDiagnostics(ctx, "Error creating resource", err, request.Plan.Schema)...)
 		return
 	}
 
@@ -61,8 +64,14 @@ func ReadResource[M any](ctx context.Context, request resource.ReadRequest, resp
 
 	resp, err := readFunc(ctx, resourceModel.GetID())
 
+	if IsNotFound(err) {
+		tflog.Warn(ctx, "Resource not found, removing from state", map[string]any{"id": resourceModel.GetID()})
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
-		response.Diagnostics.AddError("Error reading resource", "Error reading resource: "+err.Error())
+		response.Diagnostics.Append(APIErrorDiagnostics(ctx, "Error reading resource", err, request.State.Schema)...)
 		return
 	}
 
@@ -94,7 +103,7 @@ func UpdateResource[M any](ctx context.Context, request resource.UpdateRequest,
 	resp, err := updateFunc(ctx, resourceModel.GetID(), updatedResource)
 
 	if err != nil {
-		response.Diagnostics.AddError("Error updating resource", fmt.Sprintf("Error: %s", err))
+		response.Diagnostics.Append(APIErrorDiagnostics(ctx, "Error updating resource", err, request.Plan.Schema)...)
 		return
 	}
 
@@ -116,24 +125,37 @@ func DeleteResource[M any](ctx context.Context, request resource.DeleteRequest,
 	}
 
 	err := deleteFunc(ctx, resourceModel.GetID())
-	if err != nil {
-		response.Diagnostics.AddError("Error deleting resource", fmt.Sprintf("Error: %s", err))
+	if err != nil && !IsNotFound(err) {
+		response.Diagnostics.Append(APIErrorDiagnostics(ctx, "Error deleting resource", err, request.State.Schema)...)
 		return
 	}
 }
 
-func ImportResource[M any](ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, resourceModel ResourceTransformWithID[M], getFunc func(ctx context.Context, id int64) (M, error)) {
-	importId, err := strconv.ParseInt(request.ID, 10, 64)
+func ImportResource[M any](ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, resourceModel ResourceTransformWithID[M], getFunc func(ctx context.Context, id int64) (M, error), lookup ImportLookup[M]) {
+	id := request.ID
+
+	if _, err := strconv.ParseInt(id, 10, 64); err != nil && lookup.List != nil {
+		var diags diag.Diagnostics
+		id, diags = lookup.Resolve(ctx, id)
+
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	importId, err := strconv.ParseInt(id, 10, 64)
 
 	if err != nil {
-		response.Diagnostics.AddError("Unable to convert import id", fmt.Sprintf("error converting value %s to int64", request.ID))
+		response.Diagnostics.AddError("Unable to convert import id", fmt.Sprintf("error converting value %s to int64", id))
 		return
 	}
 
 	resp, err := getFunc(ctx, importId)
 
 	if err != nil {
-		response.Diagnostics.AddError("Error importing resource", fmt.Sprintf("Error importing resource: %s", err))
+		response.Diagnostics.Append(APIErrorDiagnostics(ctx, "Error importing resource", err, response.State.Schema)...)
 		return
 	}
 
@@ -145,3 +167,68 @@ func ImportResource[M any](ctx context.Context, request resource.ImportStateRequ
 
 	response.Diagnostics.Append(response.State.Set(ctx, resourceModel)...)
 }
+
+// ImportLookup resolves prefixed import IDs, ex: title:Escalate VIP, to the ID of the
+// single resource whose attribute matches the value.
+//
+// Each resource builds its lookup in a <resource>ImportLookup function of the provider
+// package, the export command uses the same function to list the resources and name them.
+type ImportLookup[M any] struct {
+	// List returns every resource of the type.
+	List func(ctx context.Context) ([]M, error)
+	// ID returns the ID of a resource.
+	ID func(M) string
+	// Attributes maps each supported import ID prefix to the resource value it matches.
+	Attributes map[string]func(M) string
+}
+
+// Resolve returns the ID of the resource matching importID, formatted as <attribute>:<value>.
+// It fails when no resource or more than one resource matches.
+func (l ImportLookup[M]) Resolve(ctx context.Context, importID string) (id string, diags diag.Diagnostics) {
+	prefixes := make([]string, 0, len(l.Attributes))
+	for prefix := range l.Attributes {
+		prefixes = append(prefixes, prefix)
+	}
+	slices.Sort(prefixes)
+
+	attribute, value, found := strings.Cut(importID, ":")
+	getValue, supported := l.Attributes[attribute]
+
+	if !found || !supported {
+		diags.AddError(
+			"Invalid import id",
+			fmt.Sprintf("Import id %q must be either a numeric ID or one of %s followed by \":<value>\", ex: %s:example.",
+				importID, strings.Join(prefixes, ", "), prefixes[0]),
+		)
+		return id, diags
+	}
+
+	items, err := l.List(ctx)
+
+	if err != nil {
+		diags.Append(APIErrorDiagnostics(ctx, "Error importing resource", err, nil)...)
+		return id, diags
+	}
+
+	var matches []string
+
+	for _, item := range items {
+		if getValue(item) == value {
+			matches = append(matches, l.ID(item))
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError("Error importing resource", fmt.Sprintf("No resource found with %s %q.", attribute, value))
+	case 1:
+		id = matches[0]
+	default:
+		diags.AddError(
+			"Error importing resource",
+			fmt.Sprintf("Found %d resources with %s %q (IDs %s), import by ID instead.", len(matches), attribute, value, strings.Join(matches, ", ")),
+		)
+	}
+
+	return id, diags
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+func TestImportLookup_Resolve(t *testing.T) {
+	lookup := ImportLookup[zendesk.Trigger]{
+		List: func(_ context.Context) ([]zendesk.Trigger, error) {
+			return []zendesk.Trigger{
+				{ID: 1, Title: "Escalate VIP"},
+				{ID: 2, Title: "Notify requester"},
+				{ID: 3, Title: "Notify requester"},
+			}, nil
+		},
+		ID: func(trigger zendesk.Trigger) string { return strconv.FormatInt(trigger.ID, 10) },
+		Attributes: map[string]func(zendesk.Trigger) string{
+			"title": func(trigger zendesk.Trigger) string { return trigger.Title },
+		},
+	}
+
+	cases := []struct {
+		testName      string
+		lookup        ImportLookup[zendesk.Trigger]
+		input         string
+		expected      string
+		expectedError string
+	}{
+		{
+			testName: "should resolve unique match",
+			lookup:   lookup,
+			input:    "title:Escalate VIP",
+			expected: "1",
+		},
+		{
+			testName:      "should fail on no match",
+			lookup:        lookup,
+			input:         "title:Missing",
+			expectedError: `No resource found with title "Missing".`,
+		},
+		{
+			testName:      "should fail on multiple matches",
+			lookup:        lookup,
+			input:         "title:Notify requester",
+			expectedError: `Found 2 resources with title "Notify requester" (IDs 2, 3), import by ID instead.`,
+		},
+		{
+			testName:      "should fail on unsupported prefix",
+			lookup:        lookup,
+			input:         "name:Escalate VIP",
+			expectedError: `Import id "name:Escalate VIP" must be either a numeric ID or one of title followed by ":<value>", ex: title:example.`,
+		},
+		{
+			testName: "should fail on list error",
+			lookup: ImportLookup[zendesk.Trigger]{
+				List: func(_ context.Context) ([]zendesk.Trigger, error) {
+					return nil, errors.New("connection refused")
+				},
+				Attributes: lookup.Attributes,
+			},
+			input:         "title:Escalate VIP",
+			expectedError: "Error: connection refused",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			out, diags := c.lookup.Resolve(t.Context(), c.input)
+
+			if c.expectedError != "" {
+				if !diags.HasError() || diags.Errors()[0].Detail() != c.expectedError {
+					t.Fatalf(errorOutputMismatch, c.testName, diags, c.expectedError)
+				}
+				return
+			}
+
+			if diags.HasError() {
+				diagnosticErrorHelper(t, diags, "unexpected error resolving import id")
+				return
+			}
+
+			if out != c.expected {
+				t.Fatalf(errorOutputMismatch, c.testName, out, c.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package models
+
+import (
+	"context"
+	"slices"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ResourceTransformWithID[zendesk.GroupMembership] = &GroupMembershipResourceModel{}
+var _ ResourceTransformWithID[[]zendesk.GroupMembership] = &GroupMembershipsResourceModel{}
+
+// GroupMembershipResourceModel is struct for group membership payload
+// https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/
+type GroupMembershipResourceModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	URL       types.String `tfsdk:"url"`
+	UserID    types.Int64  `tfsdk:"user_id"`
+	GroupID   types.Int64  `tfsdk:"group_id"`
+	Default   types.Bool   `tfsdk:"default"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (m *GroupMembershipResourceModel) GetID() int64 {
+	return m.ID.ValueInt64()
+}
+
+func (m *GroupMembershipResourceModel) GetApiModelFromTfModel(_ context.Context) (membership zendesk.GroupMembership, diags diag.Diagnostics) {
+	membership = zendesk.GroupMembership{
+		UserID:  m.UserID.ValueInt64(),
+		GroupID: m.GroupID.ValueInt64(),
+		Default: m.Default.ValueBool(),
+	}
+
+	return membership, diags
+}
+
+func (m *GroupMembershipResourceModel) GetTfModelFromApiModel(_ context.Context, membership zendesk.GroupMembership) (diags diag.Diagnostics) {
+	*m = GroupMembershipResourceModel{
+		ID:        types.Int64Value(membership.ID),
+		URL:       types.StringValue(membership.URL),
+		UserID:    types.Int64Value(membership.UserID),
+		GroupID:   types.Int64Value(membership.GroupID),
+		Default:   types.BoolValue(membership.Default),
+		CreatedAt: types.StringValue(membership.CreatedAt.UTC().String()),
+		UpdatedAt: types.StringValue(membership.UpdatedAt.UTC().String()),
+	}
+
+	return diags
+}
+
+// GroupMembershipsResourceModel is the full member set of a group, its ID is the group ID.
+type GroupMembershipsResourceModel struct {
+	ID      types.Int64 `tfsdk:"id"`
+	GroupID types.Int64 `tfsdk:"group_id"`
+	UserIDs types.Set   `tfsdk:"user_ids"`
+}
+
+func (m *GroupMembershipsResourceModel) GetID() int64 {
+	return m.GroupID.ValueInt64()
+}
+
+// GetApiModelFromTfModel returns one membership of the group per user.
+func (m *GroupMembershipsResourceModel) GetApiModelFromTfModel(ctx context.Context) (memberships []zendesk.GroupMembership, diags diag.Diagnostics) {
+	userIDs, diags := m.GetUserIDs(ctx)
+
+	if diags.HasError() {
+		return memberships, diags
+	}
+
+	for _, userID := range userIDs {
+		memberships = append(memberships, zendesk.GroupMembership{
+			UserID:  userID,
+			GroupID: m.GroupID.ValueInt64(),
+		})
+	}
+
+	return memberships, diags
+}
+
+// GetTfModelFromApiModel keeps the group ID, a group without members returns no membership
+// to take it from.
+func (m *GroupMembershipsResourceModel) GetTfModelFromApiModel(ctx context.Context, memberships []zendesk.GroupMembership) (diags diag.Diagnostics) {
+	userIDs := make([]int64, 0, len(memberships))
+
+	for _, membership := range memberships {
+		userIDs = append(userIDs, membership.UserID)
+	}
+
+	slices.Sort(userIDs)
+
+	userIDSet, diags := types.SetValueFrom(ctx, types.Int64Type, userIDs)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	*m = GroupMembershipsResourceModel{
+		ID:      m.GroupID,
+		GroupID: m.GroupID,
+		UserIDs: userIDSet,
+	}
+
+	return diags
+}
+
+// GetUserIDs returns the IDs of the members, sorted.
+func (m *GroupMembershipsResourceModel) GetUserIDs(ctx context.Context) (userIDs []int64, diags diag.Diagnostics) {
+	if m.UserIDs.IsNull() || m.UserIDs.IsUnknown() {
+		return userIDs, diags
+	}
+
+	diags.Append(m.UserIDs.ElementsAs(ctx, &userIDs, false)...)
+	slices.Sort(userIDs)
+
+	return userIDs, diags
+}
@@ -0,0 +1,140 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGroupMembershipResourceModel_GetApiModelFromTfModel(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    GroupMembershipResourceModel
+		expected zendesk.GroupMembership
+	}{
+		{
+			testName: "should get a api model from a tf resource",
+			input: GroupMembershipResourceModel{
+				UserID:  types.Int64Value(testId),
+				GroupID: types.Int64Value(2),
+				Default: types.BoolValue(true),
+			},
+			expected: zendesk.GroupMembership{
+				UserID:  testId,
+				GroupID: 2,
+				Default: true,
+			},
+		},
+		{
+			testName: "should not make the membership default when unknown",
+			input: GroupMembershipResourceModel{
+				UserID:  types.Int64Value(testId),
+				GroupID: types.Int64Value(2),
+				Default: types.BoolUnknown(),
+			},
+			expected: zendesk.GroupMembership{
+				UserID:  testId,
+				GroupID: 2,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			out, _ := c.input.GetApiModelFromTfModel(t.Context())
+			if !reflect.DeepEqual(out, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, c.expected)
+			}
+		})
+	}
+}
+
+func TestGroupMembershipResourceModel_GetTfModelFromApiModel(t *testing.T) {
+	input := zendesk.GroupMembership{
+		ID:        3,
+		URL:       testUrl,
+		UserID:    testId,
+		GroupID:   2,
+		Default:   true,
+		CreatedAt: testCreatedAt,
+		UpdatedAt: testUpdatedAt,
+	}
+	expected := GroupMembershipResourceModel{
+		ID:        types.Int64Value(3),
+		URL:       types.StringValue(testUrl),
+		UserID:    types.Int64Value(testId),
+		GroupID:   types.Int64Value(2),
+		Default:   types.BoolValue(true),
+		CreatedAt: types.StringValue(testCreatedAt.UTC().String()),
+		UpdatedAt: types.StringValue(testUpdatedAt.UTC().String()),
+	}
+
+	var out GroupMembershipResourceModel
+	out.GetTfModelFromApiModel(t.Context(), input)
+
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf(errorOutputMismatch, "should generate TF resource model from api model", out, expected)
+	}
+}
+
+func TestGroupMembershipsResourceModel_GetApiModelFromTfModel(t *testing.T) {
+	input := GroupMembershipsResourceModel{
+		GroupID: types.Int64Value(2),
+		UserIDs: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(20), types.Int64Value(10)}),
+	}
+	expected := []zendesk.GroupMembership{
+		{UserID: 10, GroupID: 2},
+		{UserID: 20, GroupID: 2},
+	}
+
+	out, diags := input.GetApiModelFromTfModel(t.Context())
+	if diags.HasError() {
+		t.Fatalf("GetApiModelFromTfModel() got error: %v", diags.Errors())
+	}
+
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf(errorOutputMismatch, "should get one membership per user", out, expected)
+	}
+}
+
+func TestGroupMembershipsResourceModel_GetTfModelFromApiModel(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    []zendesk.GroupMembership
+		expected GroupMembershipsResourceModel
+	}{
+		{
+			testName: "should generate TF resource model from api model, keeping the group id",
+			input: []zendesk.GroupMembership{
+				{ID: 1, UserID: 10, GroupID: 2},
+				{ID: 2, UserID: 20, GroupID: 2},
+			},
+			expected: GroupMembershipsResourceModel{
+				ID:      types.Int64Value(2),
+				GroupID: types.Int64Value(2),
+				UserIDs: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(10), types.Int64Value(20)}),
+			},
+		},
+		{
+			testName: "should generate an empty set for a group without members",
+			expected: GroupMembershipsResourceModel{
+				ID:      types.Int64Value(2),
+				GroupID: types.Int64Value(2),
+				UserIDs: types.SetValueMust(types.Int64Type, []attr.Value{}),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			target := GroupMembershipsResourceModel{GroupID: types.Int64Value(2)}
+			target.GetTfModelFromApiModel(t.Context(), c.input)
+			if !reflect.DeepEqual(target, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, target, c.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package models
+
+import "github.com/JacobPotter/go-zendesk/zendesk"
+
+// MacroOrderID is the ID of the macro order, there is one per account.
+const MacroOrderID = "macro_order"
+
+// MacroIDsByPosition returns the IDs of the macros ordered by position, macros sharing a
+// position are ordered by ID.
+func MacroIDsByPosition(macros []zendesk.Macro) []int64 {
+	return idsByPosition(macros,
+		func(macro zendesk.Macro) int64 { return macro.ID },
+		func(macro zendesk.Macro) int64 { return int64(macro.Position) },
+	)
+}
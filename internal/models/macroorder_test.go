@@ -0,0 +1,22 @@
+package models
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+func TestMacroIDsByPosition(t *testing.T) {
+	macros := []zendesk.Macro{
+		{ID: 3, Position: 1},
+		{ID: 2, Position: 0},
+		{ID: 1, Position: 1},
+	}
+
+	out := MacroIDsByPosition(macros)
+
+	if !slices.Equal(out, []int64{2, 1, 3}) {
+		t.Fatalf(errorOutputMismatch, "should order the macros by position then ID", out, []int64{2, 1, 3})
+	}
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// idsByPosition returns the IDs of the items ordered by position, items sharing a position are
+// ordered by ID.
+func idsByPosition[T any, ID cmp.Ordered](items []T, id func(T) ID, position func(T) int64) []ID {
+	items = slices.Clone(items)
+	slices.SortFunc(items, func(a, b T) int {
+		return cmp.Or(cmp.Compare(position(a), position(b)), cmp.Compare(id(a), id(b)))
+	})
+
+	ids := make([]ID, 0, len(items))
+
+	for _, item := range items {
+		ids = append(ids, id(item))
+	}
+
+	return ids
+}
+
+// OrderedIDs returns the IDs of an order resource in their configured order.
+func OrderedIDs[ID comparable](ctx context.Context, listed types.List) (ids []ID, diags diag.Diagnostics) {
+	ids = make([]ID, 0, len(listed.Elements()))
+
+	diags.Append(listed.ElementsAs(ctx, &ids, false)...)
+
+	return ids, diags
+}
+
+// ListedIDOrder returns the IDs in their current order. Only the IDs already listed are kept,
+// deleted items are dropped, a null list (on import) keeps every ID.
+func ListedIDOrder[ID comparable](ctx context.Context, listed types.List, ids []ID) (types.List, diag.Diagnostics) {
+	listedIDs, diags := OrderedIDs[ID](ctx, listed)
+
+	if diags.HasError() {
+		return types.ListNull(listed.ElementType(ctx)), diags
+	}
+
+	if len(listedIDs) > 0 {
+		ids = slices.DeleteFunc(slices.Clone(ids), func(id ID) bool {
+			return !slices.Contains(listedIDs, id)
+		})
+	}
+
+	return types.ListValueFrom(ctx, listed.ElementType(ctx), ids)
+}
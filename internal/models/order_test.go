@@ -0,0 +1,50 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestListedIDOrder(t *testing.T) {
+	ids := []int64{2, 1, 3, 4}
+
+	cases := []struct {
+		testName string
+		listed   types.List
+		expected []attr.Value
+	}{
+		{
+			testName: "should keep every ID on import",
+			listed:   types.ListNull(types.Int64Type),
+			expected: []attr.Value{types.Int64Value(2), types.Int64Value(1), types.Int64Value(3), types.Int64Value(4)},
+		},
+		{
+			testName: "should only keep the listed IDs still in Zendesk",
+			listed: types.ListValueMust(types.Int64Type, []attr.Value{
+				types.Int64Value(4),
+				types.Int64Value(3),
+				types.Int64Value(5),
+			}),
+			expected: []attr.Value{types.Int64Value(3), types.Int64Value(4)},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			expected := types.ListValueMust(types.Int64Type, c.expected)
+
+			out, diags := ListedIDOrder(t.Context(), c.listed, ids)
+
+			if diags.HasError() {
+				diagnosticErrorHelper(t, diags, "Diagnostic Error found running 'ListedIDOrder'")
+			}
+
+			if !reflect.DeepEqual(out, expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, expected)
+			}
+		})
+	}
+}
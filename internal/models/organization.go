@@ -0,0 +1,102 @@
+package models
+
+import (
+	"context"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ResourceTransformWithID[zendesk.Organization] = &OrganizationResourceModel{}
+
+// OrganizationResourceModel is struct for organization payload
+// https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/
+type OrganizationResourceModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	URL                types.String `tfsdk:"url"`
+	Name               types.String `tfsdk:"name"`
+	ExternalID         types.String `tfsdk:"external_id"`
+	Details            types.String `tfsdk:"details"`
+	Notes              types.String `tfsdk:"notes"`
+	DomainNames        types.Set    `tfsdk:"domain_names"`
+	GroupID            types.Int64  `tfsdk:"group_id"`
+	SharedTickets      types.Bool   `tfsdk:"shared_tickets"`
+	SharedComments     types.Bool   `tfsdk:"shared_comments"`
+	Tags               types.Set    `tfsdk:"tags"`
+	OrganizationFields types.Map    `tfsdk:"organization_fields"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+}
+
+func (o *OrganizationResourceModel) GetID() int64 {
+	return o.ID.ValueInt64()
+}
+
+func (o *OrganizationResourceModel) GetApiModelFromTfModel(ctx context.Context) (organization zendesk.Organization, diags diag.Diagnostics) {
+	var domainNames, tags []string
+
+	if !o.DomainNames.IsNull() && !o.DomainNames.IsUnknown() {
+		diags.Append(o.DomainNames.ElementsAs(ctx, &domainNames, false)...)
+	}
+
+	if !o.Tags.IsNull() && !o.Tags.IsUnknown() {
+		diags.Append(o.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+
+	organizationFields, d := getApiCustomFieldValuesFromTf(ctx, o.OrganizationFields)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return organization, diags
+	}
+
+	organization = zendesk.Organization{
+		Name:               o.Name.ValueString(),
+		ExternalID:         o.ExternalID.ValueString(),
+		Details:            o.Details.ValueString(),
+		Notes:              o.Notes.ValueString(),
+		DomainNames:        domainNames,
+		GroupID:            o.GroupID.ValueInt64(),
+		SharedTickets:      o.SharedTickets.ValueBool(),
+		SharedComments:     o.SharedComments.ValueBool(),
+		Tags:               tags,
+		OrganizationFields: organizationFields,
+	}
+
+	return organization, diags
+}
+
+func (o *OrganizationResourceModel) GetTfModelFromApiModel(ctx context.Context, organization zendesk.Organization) (diags diag.Diagnostics) {
+	domainNames, d := types.SetValueFrom(ctx, types.StringType, nonNilStrings(organization.DomainNames))
+	diags.Append(d...)
+
+	tags, d := types.SetValueFrom(ctx, types.StringType, nonNilStrings(organization.Tags))
+	diags.Append(d...)
+
+	organizationFields, d := getTfCustomFieldValuesFromApi(ctx, o.OrganizationFields, organization.OrganizationFields)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	*o = OrganizationResourceModel{
+		ID:                 types.Int64Value(organization.ID),
+		URL:                types.StringValue(organization.URL),
+		Name:               types.StringValue(organization.Name),
+		ExternalID:         types.StringValue(organization.ExternalID),
+		Details:            types.StringValue(organization.Details),
+		Notes:              types.StringValue(organization.Notes),
+		DomainNames:        domainNames,
+		GroupID:            int64ValueOrNull(organization.GroupID),
+		SharedTickets:      types.BoolValue(organization.SharedTickets),
+		SharedComments:     types.BoolValue(organization.SharedComments),
+		Tags:               tags,
+		OrganizationFields: organizationFields,
+		CreatedAt:          types.StringValue(organization.CreatedAt.UTC().String()),
+		UpdatedAt:          types.StringValue(organization.UpdatedAt.UTC().String()),
+	}
+
+	return diags
+}
@@ -0,0 +1,165 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOrganizationResourceModel_GetApiModelFromTfModel(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    OrganizationResourceModel
+		expected zendesk.Organization
+	}{
+		{
+			testName: "should get a api model from a tf resource",
+			input: OrganizationResourceModel{
+				Name:           types.StringValue(testTitle),
+				ExternalID:     types.StringValue("tenant-1"),
+				Details:        types.StringValue(testDescription),
+				Notes:          types.StringUnknown(),
+				DomainNames:    types.SetValueMust(types.StringType, []attr.Value{types.StringValue("example.org")}),
+				GroupID:        types.Int64Value(testId),
+				SharedTickets:  types.BoolValue(true),
+				SharedComments: types.BoolValue(false),
+				Tags:           types.SetValueMust(types.StringType, []attr.Value{types.StringValue("vip")}),
+				OrganizationFields: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"tier": types.StringValue("gold"),
+				}),
+			},
+			expected: zendesk.Organization{
+				Name:               testTitle,
+				ExternalID:         "tenant-1",
+				Details:            testDescription,
+				DomainNames:        []string{"example.org"},
+				GroupID:            testId,
+				SharedTickets:      true,
+				Tags:               []string{"vip"},
+				OrganizationFields: map[string]interface{}{"tier": "gold"},
+			},
+		},
+		{
+			testName: "should leave unknown organization fields out",
+			input: OrganizationResourceModel{
+				Name:               types.StringValue(testTitle),
+				DomainNames:        types.SetValueMust(types.StringType, []attr.Value{}),
+				GroupID:            types.Int64Null(),
+				Tags:               types.SetValueMust(types.StringType, []attr.Value{}),
+				OrganizationFields: types.MapUnknown(types.StringType),
+			},
+			expected: zendesk.Organization{
+				Name:        testTitle,
+				DomainNames: []string{},
+				Tags:        []string{},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			out, diags := c.input.GetApiModelFromTfModel(t.Context())
+			if diags.HasError() {
+				t.Fatalf("GetApiModelFromTfModel() got error: %v", diags.Errors())
+			}
+			if !reflect.DeepEqual(out, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, c.expected)
+			}
+		})
+	}
+}
+
+func TestOrganizationResourceModel_GetTfModelFromApiModel(t *testing.T) {
+	apiOrganization := zendesk.Organization{
+		ID:             testId,
+		URL:            testUrl,
+		Name:           testTitle,
+		ExternalID:     "tenant-1",
+		Details:        testDescription,
+		DomainNames:    []string{"example.org"},
+		GroupID:        0,
+		SharedTickets:  true,
+		SharedComments: true,
+		Tags:           nil,
+		CreatedAt:      testCreatedAt,
+		UpdatedAt:      testUpdatedAt,
+		OrganizationFields: map[string]interface{}{
+			"tier":        "gold",
+			"seats":       float64(25),
+			"premium":     false,
+			"renewal_day": nil,
+		},
+	}
+
+	expected := func(organizationFields map[string]attr.Value) OrganizationResourceModel {
+		return OrganizationResourceModel{
+			ID:                 types.Int64Value(testId),
+			URL:                types.StringValue(testUrl),
+			Name:               types.StringValue(testTitle),
+			ExternalID:         types.StringValue("tenant-1"),
+			Details:            types.StringValue(testDescription),
+			Notes:              types.StringValue(""),
+			DomainNames:        types.SetValueMust(types.StringType, []attr.Value{types.StringValue("example.org")}),
+			GroupID:            types.Int64Null(),
+			SharedTickets:      types.BoolValue(true),
+			SharedComments:     types.BoolValue(true),
+			Tags:               types.SetValueMust(types.StringType, []attr.Value{}),
+			OrganizationFields: types.MapValueMust(types.StringType, organizationFields),
+			CreatedAt:          types.StringValue(testCreatedAt.UTC().String()),
+			UpdatedAt:          types.StringValue(testUpdatedAt.UTC().String()),
+		}
+	}
+
+	cases := []struct {
+		testName string
+		target   OrganizationResourceModel
+		input    zendesk.Organization
+		expected OrganizationResourceModel
+	}{
+		{
+			testName: "should keep every organization field with a value without prior fields",
+			target:   OrganizationResourceModel{OrganizationFields: types.MapNull(types.StringType)},
+			input:    apiOrganization,
+			expected: expected(map[string]attr.Value{
+				"tier":    types.StringValue("gold"),
+				"seats":   types.StringValue("25"),
+				"premium": types.StringValue("false"),
+			}),
+		},
+		{
+			testName: "should keep only the prior organization fields",
+			target: OrganizationResourceModel{OrganizationFields: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"seats": types.StringValue("25"),
+			})},
+			input: apiOrganization,
+			expected: expected(map[string]attr.Value{
+				"seats": types.StringValue("25"),
+			}),
+		},
+		{
+			testName: "should keep a prior organization field Zendesk stores without a value",
+			target: OrganizationResourceModel{OrganizationFields: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"renewal_day": types.StringValue(""),
+			})},
+			input: apiOrganization,
+			expected: expected(map[string]attr.Value{
+				"renewal_day": types.StringValue(""),
+			}),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			diags := c.target.GetTfModelFromApiModel(t.Context(), c.input)
+			if diags.HasError() {
+				t.Fatalf("GetTfModelFromApiModel() got error: %v", diags.Errors())
+			}
+			if !reflect.DeepEqual(c.target, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, c.target, c.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package models
+
+import (
+	"context"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ResourceTransform[zendeskapi.RoutingAttribute] = &RoutingAttributeResourceModel{}
+
+// RoutingAttributeResourceModel is struct for routing attribute payload
+// https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/
+type RoutingAttributeResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	URL       types.String `tfsdk:"url"`
+	Name      types.String `tfsdk:"name"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (a *RoutingAttributeResourceModel) GetApiModelFromTfModel(_ context.Context) (attribute zendeskapi.RoutingAttribute, diags diag.Diagnostics) {
+	attribute = zendeskapi.RoutingAttribute{
+		Name: a.Name.ValueString(),
+	}
+
+	return attribute, diags
+}
+
+func (a *RoutingAttributeResourceModel) GetTfModelFromApiModel(_ context.Context, attribute zendeskapi.RoutingAttribute) (diags diag.Diagnostics) {
+	*a = RoutingAttributeResourceModel{
+		ID:        types.StringValue(attribute.ID),
+		URL:       types.StringValue(attribute.URL),
+		Name:      types.StringValue(attribute.Name),
+		CreatedAt: types.StringNull(),
+		UpdatedAt: types.StringNull(),
+	}
+
+	if attribute.CreatedAt != nil {
+		a.CreatedAt = types.StringValue(attribute.CreatedAt.UTC().String())
+	}
+
+	if attribute.UpdatedAt != nil {
+		a.UpdatedAt = types.StringValue(attribute.UpdatedAt.UTC().String())
+	}
+
+	return diags
+}
+
+var _ ResourceTransform[zendeskapi.RoutingAttributeValue] = &RoutingAttributeValueResourceModel{}
+
+// RoutingAttributeValueResourceModel is struct for routing attribute value payload, the
+// conditions are the ones of triggers.
+type RoutingAttributeValueResourceModel struct {
+	ID          types.String             `tfsdk:"id"`
+	AttributeID types.String             `tfsdk:"attribute_id"`
+	URL         types.String             `tfsdk:"url"`
+	Name        types.String             `tfsdk:"name"`
+	Conditions  *ConditionsResourceModel `tfsdk:"conditions"`
+	CreatedAt   types.String             `tfsdk:"created_at"`
+	UpdatedAt   types.String             `tfsdk:"updated_at"`
+}
+
+func (v *RoutingAttributeValueResourceModel) GetApiModelFromTfModel(ctx context.Context) (value zendeskapi.RoutingAttributeValue, diags diag.Diagnostics) {
+	value = zendeskapi.RoutingAttributeValue{
+		Name: v.Name.ValueString(),
+	}
+
+	value.Conditions, diags = getApiRoutingConditionsFromTf(ctx, v.Conditions)
+
+	return value, diags
+}
+
+// GetTfModelFromApiModel keeps the attribute ID, which is not part of the value payload.
+func (v *RoutingAttributeValueResourceModel) GetTfModelFromApiModel(ctx context.Context, value zendeskapi.RoutingAttributeValue) (diags diag.Diagnostics) {
+	conditions, diags := getTfRoutingConditionsFromApi(ctx, v.Conditions, value.Conditions)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	*v = RoutingAttributeValueResourceModel{
+		ID:          types.StringValue(value.ID),
+		AttributeID: v.AttributeID,
+		URL:         types.StringValue(value.URL),
+		Name:        types.StringValue(value.Name),
+		Conditions:  conditions,
+		CreatedAt:   types.StringNull(),
+		UpdatedAt:   types.StringNull(),
+	}
+
+	if value.CreatedAt != nil {
+		v.CreatedAt = types.StringValue(value.CreatedAt.UTC().String())
+	}
+
+	if value.UpdatedAt != nil {
+		v.UpdatedAt = types.StringValue(value.UpdatedAt.UTC().String())
+	}
+
+	return diags
+}
+
+// getApiRoutingConditionsFromTf converts trigger conditions to the routing API format, null
+// conditions are sent empty so removing them from the configuration clears them.
+func getApiRoutingConditionsFromTf(ctx context.Context, conditionModels *ConditionsResourceModel) (*zendeskapi.RoutingConditions, diag.Diagnostics) {
+	if conditionModels == nil {
+		return &zendeskapi.RoutingConditions{All: []zendeskapi.RoutingCondition{}, Any: []zendeskapi.RoutingCondition{}}, nil
+	}
+
+	conditions, diags := getApiConditionsFromTf(ctx, *conditionModels)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return zendeskapi.NewRoutingConditions(conditions), diags
+}
+
+// getTfRoutingConditionsFromApi returns null conditions when Zendesk returns none, and the known
+// conditions when Zendesk doesn't return them.
+func getTfRoutingConditionsFromApi(ctx context.Context, known *ConditionsResourceModel, conditions *zendeskapi.RoutingConditions) (*ConditionsResourceModel, diag.Diagnostics) {
+	if conditions == nil {
+		return known, nil
+	}
+
+	if len(conditions.All) == 0 && len(conditions.Any) == 0 {
+		return nil, nil
+	}
+
+	tfConditions, diags := getTfConditionsFromApi(ctx, conditions.Conditions())
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &tfConditions, diags
+}
@@ -0,0 +1,163 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testRoutingID = "15821cba-7326-11e8-b07e-950ba849aa27"
+
+func TestRoutingAttributeResourceModel_GetTfModelFromApiModel(t *testing.T) {
+	attribute := zendeskapi.RoutingAttribute{
+		ID:        testRoutingID,
+		URL:       testUrl,
+		Name:      testTitle,
+		CreatedAt: &testCreatedAt,
+		UpdatedAt: &testUpdatedAt,
+	}
+
+	expected := RoutingAttributeResourceModel{
+		ID:        types.StringValue(testRoutingID),
+		URL:       types.StringValue(testUrl),
+		Name:      types.StringValue(testTitle),
+		CreatedAt: types.StringValue(testCreatedAt.UTC().String()),
+		UpdatedAt: types.StringValue(testUpdatedAt.UTC().String()),
+	}
+
+	var out RoutingAttributeResourceModel
+	out.GetTfModelFromApiModel(t.Context(), attribute)
+
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf(errorOutputMismatch, "should get a tf model from a routing attribute", out, expected)
+	}
+}
+
+func TestRoutingAttributeValueResourceModel_GetApiModelFromTfModel(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    RoutingAttributeValueResourceModel
+		expected zendeskapi.RoutingAttributeValue
+	}{
+		{
+			testName: "should map the conditions to the routing format",
+			input: RoutingAttributeValueResourceModel{
+				AttributeID: types.StringValue(testRoutingID),
+				Name:        types.StringValue(testTitle),
+				Conditions: &ConditionsResourceModel{
+					All: []ConditionResourceModel{
+						{
+							Field:         types.StringValue("custom_field"),
+							Operator:      types.StringValue("is"),
+							Value:         types.StringValue("premium"),
+							Values:        types.ListNull(types.StringType),
+							CustomFieldID: types.Int64Value(testId),
+						},
+					},
+				},
+			},
+			expected: zendeskapi.RoutingAttributeValue{
+				Name: testTitle,
+				Conditions: &zendeskapi.RoutingConditions{
+					All: []zendeskapi.RoutingCondition{
+						{Subject: "custom_fields_123", Operator: "is", Value: zendesk.ParsedValue{Data: "premium"}},
+					},
+					Any: []zendeskapi.RoutingCondition{},
+				},
+			},
+		},
+		{
+			testName: "should clear the conditions when they are not configured",
+			input: RoutingAttributeValueResourceModel{
+				AttributeID: types.StringValue(testRoutingID),
+				Name:        types.StringValue(testTitle),
+			},
+			expected: zendeskapi.RoutingAttributeValue{
+				Name: testTitle,
+				Conditions: &zendeskapi.RoutingConditions{
+					All: []zendeskapi.RoutingCondition{},
+					Any: []zendeskapi.RoutingCondition{},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			out, _ := c.input.GetApiModelFromTfModel(t.Context())
+			if !reflect.DeepEqual(out, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, c.expected)
+			}
+		})
+	}
+}
+
+func TestRoutingAttributeValueResourceModel_GetTfModelFromApiModel(t *testing.T) {
+	knownConditions := &ConditionsResourceModel{
+		Any: []ConditionResourceModel{
+			{
+				Field:    types.StringValue("via_id"),
+				Operator: types.StringValue("is"),
+				Value:    types.StringValue("4"),
+				Values:   types.ListNull(types.StringType),
+			},
+		},
+	}
+
+	cases := []struct {
+		testName           string
+		stateConditions    *ConditionsResourceModel
+		conditions         *zendeskapi.RoutingConditions
+		expectedConditions *ConditionsResourceModel
+	}{
+		{
+			testName: "should get the conditions returned by Zendesk",
+			conditions: &zendeskapi.RoutingConditions{
+				Any: []zendeskapi.RoutingCondition{{Subject: "via_id", Operator: "is", Value: zendesk.ParsedValue{Data: "4"}}},
+			},
+			expectedConditions: knownConditions,
+		},
+		{
+			testName:           "should keep the known conditions when Zendesk doesn't return them",
+			stateConditions:    knownConditions,
+			expectedConditions: knownConditions,
+		},
+		{
+			testName:        "should set null conditions when Zendesk returns none",
+			stateConditions: knownConditions,
+			conditions:      &zendeskapi.RoutingConditions{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			out := RoutingAttributeValueResourceModel{
+				AttributeID: types.StringValue(testRoutingID),
+				Conditions:  c.stateConditions,
+			}
+			out.GetTfModelFromApiModel(t.Context(), zendeskapi.RoutingAttributeValue{
+				ID:         "b376b35a-e38b-11e8-a292-e3b6377c5575",
+				URL:        testUrl,
+				Name:       testTitle,
+				Conditions: c.conditions,
+			})
+
+			expected := RoutingAttributeValueResourceModel{
+				ID:          types.StringValue("b376b35a-e38b-11e8-a292-e3b6377c5575"),
+				AttributeID: types.StringValue(testRoutingID),
+				URL:         types.StringValue(testUrl),
+				Name:        types.StringValue(testTitle),
+				Conditions:  c.expectedConditions,
+				CreatedAt:   types.StringNull(),
+				UpdatedAt:   types.StringNull(),
+			}
+
+			if !reflect.DeepEqual(out, expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package models
+
+import (
+	"context"
+	"slices"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ResourceTransform[zendeskapi.RoutingQueue] = &RoutingQueueResourceModel{}
+
+// RoutingQueueResourceModel is struct for omnichannel routing queue payload, the conditions are
+// the queue definition.
+// https://developer.zendesk.com/api-reference/agent-availability/omnichannel-routing-queues/
+type RoutingQueueResourceModel struct {
+	ID              types.String             `tfsdk:"id"`
+	URL             types.String             `tfsdk:"url"`
+	Name            types.String             `tfsdk:"name"`
+	Description     types.String             `tfsdk:"description"`
+	Priority        types.Int64              `tfsdk:"priority"`
+	Order           types.Int64              `tfsdk:"order"`
+	PrimaryGroups   types.Set                `tfsdk:"primary_groups"`
+	SecondaryGroups types.Set                `tfsdk:"secondary_groups"`
+	Conditions      *ConditionsResourceModel `tfsdk:"conditions"`
+	CreatedAt       types.String             `tfsdk:"created_at"`
+	UpdatedAt       types.String             `tfsdk:"updated_at"`
+}
+
+func (q *RoutingQueueResourceModel) GetApiModelFromTfModel(ctx context.Context) (queue zendeskapi.RoutingQueue, diags diag.Diagnostics) {
+	primaryGroupIDs := make([]int64, 0, len(q.PrimaryGroups.Elements()))
+	diags.Append(q.PrimaryGroups.ElementsAs(ctx, &primaryGroupIDs, false)...)
+
+	secondaryGroupIDs := make([]int64, 0, len(q.SecondaryGroups.Elements()))
+	diags.Append(q.SecondaryGroups.ElementsAs(ctx, &secondaryGroupIDs, false)...)
+
+	if diags.HasError() {
+		return queue, diags
+	}
+
+	slices.Sort(primaryGroupIDs)
+	slices.Sort(secondaryGroupIDs)
+
+	definition, diags := getApiRoutingConditionsFromTf(ctx, q.Conditions)
+
+	if diags.HasError() {
+		return queue, diags
+	}
+
+	queue = zendeskapi.RoutingQueue{
+		Name:              q.Name.ValueString(),
+		Description:       q.Description.ValueString(),
+		Priority:          q.Priority.ValueInt64(),
+		Definition:        definition,
+		PrimaryGroupIDs:   primaryGroupIDs,
+		SecondaryGroupIDs: secondaryGroupIDs,
+	}
+
+	return queue, diags
+}
+
+func (q *RoutingQueueResourceModel) GetTfModelFromApiModel(ctx context.Context, queue zendeskapi.RoutingQueue) (diags diag.Diagnostics) {
+	primaryGroupIDs := queue.PrimaryGroups.IDs()
+	slices.Sort(primaryGroupIDs)
+
+	secondaryGroupIDs := queue.SecondaryGroups.IDs()
+	slices.Sort(secondaryGroupIDs)
+
+	primaryGroups, d := types.SetValueFrom(ctx, types.Int64Type, primaryGroupIDs)
+	diags.Append(d...)
+
+	secondaryGroups, d := types.SetValueFrom(ctx, types.Int64Type, secondaryGroupIDs)
+	diags.Append(d...)
+
+	conditions, d := getTfRoutingConditionsFromApi(ctx, q.Conditions, queue.Definition)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	*q = RoutingQueueResourceModel{
+		ID:              types.StringValue(queue.ID),
+		URL:             types.StringValue(queue.URL),
+		Name:            types.StringValue(queue.Name),
+		Description:     types.StringValue(queue.Description),
+		Priority:        types.Int64Value(queue.Priority),
+		Order:           types.Int64Value(queue.Order),
+		PrimaryGroups:   primaryGroups,
+		SecondaryGroups: secondaryGroups,
+		Conditions:      conditions,
+		CreatedAt:       types.StringNull(),
+		UpdatedAt:       types.StringNull(),
+	}
+
+	if queue.CreatedAt != nil {
+		q.CreatedAt = types.StringValue(queue.CreatedAt.UTC().String())
+	}
+
+	if queue.UpdatedAt != nil {
+		q.UpdatedAt = types.StringValue(queue.UpdatedAt.UTC().String())
+	}
+
+	return diags
+}
@@ -0,0 +1,93 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var testRoutingQueueConditions = &ConditionsResourceModel{
+	All: []ConditionResourceModel{
+		{
+			Field:    types.StringValue("priority"),
+			Operator: types.StringValue("is"),
+			Value:    types.StringValue("urgent"),
+			Values:   types.ListNull(types.StringType),
+		},
+	},
+}
+
+func TestRoutingQueueResourceModel_GetApiModelFromTfModel(t *testing.T) {
+	input := RoutingQueueResourceModel{
+		Name:            types.StringValue(testTitle),
+		Description:     types.StringValue(testDescription),
+		Priority:        types.Int64Value(2),
+		Order:           types.Int64Value(1),
+		PrimaryGroups:   types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(2), types.Int64Value(1)}),
+		SecondaryGroups: types.SetValueMust(types.Int64Type, []attr.Value{}),
+		Conditions:      testRoutingQueueConditions,
+	}
+
+	expected := zendeskapi.RoutingQueue{
+		Name:        testTitle,
+		Description: testDescription,
+		Priority:    2,
+		Definition: &zendeskapi.RoutingConditions{
+			All: []zendeskapi.RoutingCondition{{Subject: "priority", Operator: "is", Value: zendesk.ParsedValue{Data: "urgent"}}},
+			Any: []zendeskapi.RoutingCondition{},
+		},
+		PrimaryGroupIDs:   []int64{1, 2},
+		SecondaryGroupIDs: []int64{},
+	}
+
+	out, _ := input.GetApiModelFromTfModel(t.Context())
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf(errorOutputMismatch, "should get a routing queue with sorted group IDs", out, expected)
+	}
+}
+
+func TestRoutingQueueResourceModel_GetTfModelFromApiModel(t *testing.T) {
+	queue := zendeskapi.RoutingQueue{
+		ID:          "01HG80ATNNZK1N7XRFVKX48XD6",
+		URL:         testUrl,
+		Name:        testTitle,
+		Description: testDescription,
+		Priority:    2,
+		Order:       1,
+		Definition: &zendeskapi.RoutingConditions{
+			All: []zendeskapi.RoutingCondition{{Subject: "priority", Operator: "is", Value: zendesk.ParsedValue{Data: "urgent"}}},
+			Any: []zendeskapi.RoutingCondition{},
+		},
+		PrimaryGroups: &zendeskapi.RoutingQueueGroups{
+			Count:  2,
+			Groups: []zendeskapi.RoutingQueueGroup{{ID: 2, Name: "Tier 2"}, {ID: 1, Name: "Tier 1"}},
+		},
+		CreatedAt: &testCreatedAt,
+		UpdatedAt: &testUpdatedAt,
+	}
+
+	expected := RoutingQueueResourceModel{
+		ID:              types.StringValue("01HG80ATNNZK1N7XRFVKX48XD6"),
+		URL:             types.StringValue(testUrl),
+		Name:            types.StringValue(testTitle),
+		Description:     types.StringValue(testDescription),
+		Priority:        types.Int64Value(2),
+		Order:           types.Int64Value(1),
+		PrimaryGroups:   types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(2)}),
+		SecondaryGroups: types.SetValueMust(types.Int64Type, []attr.Value{}),
+		Conditions:      testRoutingQueueConditions,
+		CreatedAt:       types.StringValue(testCreatedAt.UTC().String()),
+		UpdatedAt:       types.StringValue(testUpdatedAt.UTC().String()),
+	}
+
+	var out RoutingQueueResourceModel
+	out.GetTfModelFromApiModel(t.Context(), queue)
+
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf(errorOutputMismatch, "should get a tf model from a routing queue", out, expected)
+	}
+}
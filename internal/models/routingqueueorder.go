@@ -0,0 +1,15 @@
+package models
+
+import "github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+
+// RoutingQueueOrderID is the ID of the routing queue order, there is one per account.
+const RoutingQueueOrderID = "routing_queue_order"
+
+// RoutingQueueIDsByOrder returns the IDs of the queues in their routing order, queues sharing an
+// order are ordered by ID.
+func RoutingQueueIDsByOrder(queues []zendeskapi.RoutingQueue) []string {
+	return idsByPosition(queues,
+		func(queue zendeskapi.RoutingQueue) string { return queue.ID },
+		func(queue zendeskapi.RoutingQueue) int64 { return queue.Order },
+	)
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+)
+
+func TestRoutingQueueIDsByOrder(t *testing.T) {
+	queues := []zendeskapi.RoutingQueue{
+		{ID: "c", Order: 2},
+		{ID: "b", Order: 1},
+		{ID: "a", Order: 2},
+	}
+
+	out := RoutingQueueIDsByOrder(queues)
+
+	if !slices.Equal(out, []string{"b", "a", "c"}) {
+		t.Fatalf(errorOutputMismatch, "should order the queues by order then ID", out, []string{"b", "a", "c"})
+	}
+}
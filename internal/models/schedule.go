@@ -2,26 +2,78 @@ package models
 
 import (
 	"context"
+	"fmt"
 	"github.com/JacobPotter/go-zendesk/zendesk"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"strconv"
+)
+
+const (
+	minutesPerHour = 60
+	minutesPerDay  = 24 * minutesPerHour
 )
 
 type ScheduleIntervalModel struct {
+	StartTime TimeOfDayValue `tfsdk:"start_time"`
+	EndTime   TimeOfDayValue `tfsdk:"end_time"`
+}
+
+func (m ScheduleIntervalModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"start_time": TimeOfDayType{},
+		"end_time":   TimeOfDayType{},
+	}
+}
+
+// ScheduleIntervalModelV0 had times in hours from the beginning of the day.
+type ScheduleIntervalModelV0 struct {
 	StartTime types.Int64 `tfsdk:"start_time"`
 	EndTime   types.Int64 `tfsdk:"end_time"`
 }
 
-func (m ScheduleIntervalModel) AttributeTypes() map[string]attr.Type {
+func (m ScheduleIntervalModelV0) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
 		"start_time": types.Int64Type,
 		"end_time":   types.Int64Type,
 	}
 }
 
+// ScheduleIntervalObjectModel holds the intervals of every weekday, a weekday can have
+// several intervals, ex: for split shifts.
 type ScheduleIntervalObjectModel struct {
+	Sunday    types.List `tfsdk:"sunday"`
+	Monday    types.List `tfsdk:"monday"`
+	Tuesday   types.List `tfsdk:"tuesday"`
+	Wednesday types.List `tfsdk:"wednesday"`
+	Thursday  types.List `tfsdk:"thursday"`
+	Friday    types.List `tfsdk:"friday"`
+	Saturday  types.List `tfsdk:"saturday"`
+}
+
+var scheduleDayListType = types.ListType{ElemType: types.ObjectType{AttrTypes: ScheduleIntervalModel{}.AttributeTypes()}}
+
+func (s ScheduleIntervalObjectModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"sunday":    scheduleDayListType,
+		"monday":    scheduleDayListType,
+		"tuesday":   scheduleDayListType,
+		"wednesday": scheduleDayListType,
+		"thursday":  scheduleDayListType,
+		"friday":    scheduleDayListType,
+		"saturday":  scheduleDayListType,
+	}
+}
+
+// weekdays returns the weekday intervals indexed by their day offset, starting on sunday.
+func (s *ScheduleIntervalObjectModel) weekdays() []*types.List {
+	return []*types.List{&s.Sunday, &s.Monday, &s.Tuesday, &s.Wednesday, &s.Thursday, &s.Friday, &s.Saturday}
+}
+
+// ScheduleIntervalObjectModelV0 allowed a single interval per weekday.
+type ScheduleIntervalObjectModelV0 struct {
 	Sunday    types.Object `tfsdk:"sunday"`
 	Monday    types.Object `tfsdk:"monday"`
 	Tuesday   types.Object `tfsdk:"tuesday"`
@@ -31,15 +83,15 @@ type ScheduleIntervalObjectModel struct {
 	Saturday  types.Object `tfsdk:"saturday"`
 }
 
-func (s ScheduleIntervalObjectModel) AttributeTypes() map[string]attr.Type {
+func (s ScheduleIntervalObjectModelV0) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"sunday":    types.ObjectType{AttrTypes: ScheduleIntervalModel{}.AttributeTypes()},
-		"monday":    types.ObjectType{AttrTypes: ScheduleIntervalModel{}.AttributeTypes()},
-		"tuesday":   types.ObjectType{AttrTypes: ScheduleIntervalModel{}.AttributeTypes()},
-		"wednesday": types.ObjectType{AttrTypes: ScheduleIntervalModel{}.AttributeTypes()},
-		"thursday":  types.ObjectType{AttrTypes: ScheduleIntervalModel{}.AttributeTypes()},
-		"friday":    types.ObjectType{AttrTypes: ScheduleIntervalModel{}.AttributeTypes()},
-		"saturday":  types.ObjectType{AttrTypes: ScheduleIntervalModel{}.AttributeTypes()},
+		"sunday":    types.ObjectType{AttrTypes: ScheduleIntervalModelV0{}.AttributeTypes()},
+		"monday":    types.ObjectType{AttrTypes: ScheduleIntervalModelV0{}.AttributeTypes()},
+		"tuesday":   types.ObjectType{AttrTypes: ScheduleIntervalModelV0{}.AttributeTypes()},
+		"wednesday": types.ObjectType{AttrTypes: ScheduleIntervalModelV0{}.AttributeTypes()},
+		"thursday":  types.ObjectType{AttrTypes: ScheduleIntervalModelV0{}.AttributeTypes()},
+		"friday":    types.ObjectType{AttrTypes: ScheduleIntervalModelV0{}.AttributeTypes()},
+		"saturday":  types.ObjectType{AttrTypes: ScheduleIntervalModelV0{}.AttributeTypes()},
 	}
 }
 
@@ -52,6 +104,16 @@ type ScheduleResourceModel struct {
 	UpdatedAt types.String `tfsdk:"updated_at"`
 }
 
+// ScheduleResourceModelV0 is the prior model of schema version 0, only intervals differ.
+type ScheduleResourceModelV0 struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Intervals types.Object `tfsdk:"intervals"`
+	Name      types.String `tfsdk:"name"`
+	TimeZone  types.String `tfsdk:"time_zone"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
 func (s *ScheduleResourceModel) GetID() int64 {
 	return s.ID.ValueInt64()
 }
@@ -88,74 +150,51 @@ func getApiIntervalsFromTf(ctx context.Context, tfIntervalsObj types.Object) (in
 		return intervals, diags
 	}
 
-	if !intervalsModel.Sunday.IsNull() && !intervalsModel.Sunday.IsUnknown() {
-
-		var interval zendesk.ScheduleInterval
-		interval, diags = getApiIntervalFromTf(ctx, intervalsModel.Sunday, 0)
-		intervals = append(intervals, interval)
-	}
-	if !intervalsModel.Monday.IsNull() && !intervalsModel.Monday.IsUnknown() {
-
-		var interval zendesk.ScheduleInterval
-		interval, diags = getApiIntervalFromTf(ctx, intervalsModel.Monday, 1)
-		intervals = append(intervals, interval)
-	}
-	if !intervalsModel.Tuesday.IsNull() && !intervalsModel.Tuesday.IsUnknown() {
-
-		var interval zendesk.ScheduleInterval
-		interval, diags = getApiIntervalFromTf(ctx, intervalsModel.Tuesday, 2)
-		intervals = append(intervals, interval)
-	}
-	if !intervalsModel.Wednesday.IsNull() && !intervalsModel.Wednesday.IsUnknown() {
+	for dayOffset, day := range intervalsModel.weekdays() {
+		if day.IsNull() || day.IsUnknown() {
+			continue
+		}
 
-		var interval zendesk.ScheduleInterval
-		interval, diags = getApiIntervalFromTf(ctx, intervalsModel.Wednesday, 3)
-		intervals = append(intervals, interval)
-	}
-	if !intervalsModel.Thursday.IsNull() && !intervalsModel.Thursday.IsUnknown() {
+		var dayIntervals []ScheduleIntervalModel
 
-		var interval zendesk.ScheduleInterval
-		interval, diags = getApiIntervalFromTf(ctx, intervalsModel.Thursday, 4)
-		intervals = append(intervals, interval)
-	}
-	if !intervalsModel.Friday.IsNull() && !intervalsModel.Friday.IsUnknown() {
+		diags.Append(day.ElementsAs(ctx, &dayIntervals, false)...)
 
-		var interval zendesk.ScheduleInterval
-		interval, diags = getApiIntervalFromTf(ctx, intervalsModel.Friday, 5)
-		intervals = append(intervals, interval)
-	}
-	if !intervalsModel.Saturday.IsNull() && !intervalsModel.Saturday.IsUnknown() {
+		if diags.HasError() {
+			return intervals, diags
+		}
 
-		var interval zendesk.ScheduleInterval
-		interval, diags = getApiIntervalFromTf(ctx, intervalsModel.Saturday, 6)
-		intervals = append(intervals, interval)
+		for _, intervalModel := range dayIntervals {
+			interval, err := getApiIntervalFromTf(intervalModel, dayOffset)
+			if err != nil {
+				diags.AddError("Invalid schedule interval", err.Error())
+				return intervals, diags
+			}
+			intervals = append(intervals, interval)
+		}
 	}
 
 	return intervals, diags
 
 }
 
-func getApiIntervalFromTf(ctx context.Context, dayIntervalObj types.Object, dayOffset int) (interval zendesk.ScheduleInterval, diags diag.Diagnostics) {
-	var intervalModel ScheduleIntervalModel
-
-	diags = dayIntervalObj.As(ctx, &intervalModel, basetypes.ObjectAsOptions{
-		UnhandledNullAsEmpty:    false,
-		UnhandledUnknownAsEmpty: false,
-	})
-
-	if diags.HasError() {
-		return interval, diags
+// getApiIntervalFromTf converts times of a weekday to minutes from the start of the week.
+func getApiIntervalFromTf(intervalModel ScheduleIntervalModel, dayOffset int) (interval zendesk.ScheduleInterval, err error) {
+	startTime, err := intervalModel.StartTime.Minutes()
+	if err != nil {
+		return interval, err
 	}
 
-	startTime := intervalModel.StartTime.ValueInt64()
-	endTime := intervalModel.EndTime.ValueInt64()
+	endTime, err := intervalModel.EndTime.Minutes()
+	if err != nil {
+		return interval, err
+	}
 
 	interval = zendesk.ScheduleInterval{
-		StartTime: int(startTime)*60 + (dayOffset * 24 * 60),
-		EndTime:   int(endTime)*60 + (dayOffset * 24 * 60),
+		StartTime: startTime + (dayOffset * minutesPerDay),
+		EndTime:   endTime + (dayOffset * minutesPerDay),
 	}
 
-	return interval, diags
+	return interval, nil
 }
 
 func (s *ScheduleResourceModel) GetTfModelFromApiModel(ctx context.Context, schedule zendesk.Schedule) (diags diag.Diagnostics) {
@@ -179,97 +218,98 @@ func (s *ScheduleResourceModel) GetTfModelFromApiModel(ctx context.Context, sche
 	return diags
 }
 
+// getTfIntervalsFromApi groups intervals by the weekday they start on, weekdays without
+// intervals are null.
 func getTfIntervalsFromApi(ctx context.Context, intervals []zendesk.ScheduleInterval) (intervalsObj types.Object, diags diag.Diagnostics) {
-	var sundayObj types.Object
-	var mondayObj types.Object
-	var tuesdayObj types.Object
-	var wednesdayObj types.Object
-	var thursdayObj types.Object
-	var fridayObj types.Object
-	var saturdayObj types.Object
+	dayIntervals := make([][]ScheduleIntervalModel, 7)
 
 	for _, interval := range intervals {
-		if sundayObj.IsNull() || sundayObj.IsUnknown() {
-			sundayObj, diags = getTfIntervalFromApi(ctx, interval, 0)
-			if diags.HasError() {
-				return intervalsObj, diags
-			}
+		dayOffset := interval.StartTime / minutesPerDay
+		if dayOffset < 0 || dayOffset >= len(dayIntervals) {
+			diags.AddError("Invalid schedule interval", fmt.Sprintf("Schedule interval starts outside of the week, at minute %d", interval.StartTime))
+			return intervalsObj, diags
 		}
 
-		if mondayObj.IsNull() || mondayObj.IsUnknown() {
-			mondayObj, diags = getTfIntervalFromApi(ctx, interval, 1)
-			if diags.HasError() {
-				return intervalsObj, diags
-			}
-		}
+		dayIntervals[dayOffset] = append(dayIntervals[dayOffset], getTfIntervalFromApi(interval, dayOffset))
+	}
 
-		if tuesdayObj.IsNull() || tuesdayObj.IsUnknown() {
-			tuesdayObj, diags = getTfIntervalFromApi(ctx, interval, 2)
-			if diags.HasError() {
-				return intervalsObj, diags
-			}
-		}
+	var scheduleIntervalObjModel ScheduleIntervalObjectModel
 
-		if wednesdayObj.IsNull() || wednesdayObj.IsUnknown() {
-			wednesdayObj, diags = getTfIntervalFromApi(ctx, interval, 3)
-			if diags.HasError() {
-				return intervalsObj, diags
-			}
+	for dayOffset, day := range scheduleIntervalObjModel.weekdays() {
+		if len(dayIntervals[dayOffset]) == 0 {
+			*day = types.ListNull(scheduleDayListType.ElemType)
+			continue
 		}
 
-		if thursdayObj.IsNull() || thursdayObj.IsUnknown() {
-			thursdayObj, diags = getTfIntervalFromApi(ctx, interval, 4)
-			if diags.HasError() {
-				return intervalsObj, diags
-			}
+		*day, diags = types.ListValueFrom(ctx, scheduleDayListType.ElemType, dayIntervals[dayOffset])
+		if diags.HasError() {
+			return intervalsObj, diags
 		}
+	}
 
-		if fridayObj.IsNull() || fridayObj.IsUnknown() {
-			fridayObj, diags = getTfIntervalFromApi(ctx, interval, 5)
-			if diags.HasError() {
-				return intervalsObj, diags
-			}
-		}
+	intervalsObj, diags = types.ObjectValueFrom(ctx, scheduleIntervalObjModel.AttributeTypes(), scheduleIntervalObjModel)
 
-		if saturdayObj.IsNull() || saturdayObj.IsUnknown() {
-			saturdayObj, diags = getTfIntervalFromApi(ctx, interval, 6)
-			if diags.HasError() {
-				return intervalsObj, diags
-			}
-		}
+	return intervalsObj, diags
+}
 
+// getTfIntervalFromApi converts minutes from the start of the week to times of a weekday.
+func getTfIntervalFromApi(interval zendesk.ScheduleInterval, dayOffset int) ScheduleIntervalModel {
+	return ScheduleIntervalModel{
+		StartTime: NewTimeOfDayValue(interval.StartTime - dayOffset*minutesPerDay),
+		EndTime:   NewTimeOfDayValue(interval.EndTime - dayOffset*minutesPerDay),
 	}
+}
 
-	scheduleIntervalObjModel := ScheduleIntervalObjectModel{
-		Sunday:    sundayObj,
-		Monday:    mondayObj,
-		Tuesday:   tuesdayObj,
-		Wednesday: wednesdayObj,
-		Thursday:  thursdayObj,
-		Friday:    fridayObj,
-		Saturday:  saturdayObj,
+// UpgradeScheduleIntervalsV1 turns the single interval of every weekday into a list of one
+// interval, with hours turned into times of day. Hours are kept as they were configured,
+// ex: 9, which is semantically equal to 09:00.
+func UpgradeScheduleIntervalsV1(ctx context.Context, priorIntervals types.Object) (intervals types.Object, diags diag.Diagnostics) {
+	if priorIntervals.IsNull() || priorIntervals.IsUnknown() {
+		return types.ObjectNull(ScheduleIntervalObjectModel{}.AttributeTypes()), diags
 	}
 
-	intervalsObj, diags = types.ObjectValueFrom(ctx, scheduleIntervalObjModel.AttributeTypes(), scheduleIntervalObjModel)
+	var priorModel ScheduleIntervalObjectModelV0
 
-	return intervalsObj, diags
-}
+	diags = priorIntervals.As(ctx, &priorModel, basetypes.ObjectAsOptions{})
+
+	if diags.HasError() {
+		return intervals, diags
+	}
+
+	priorDays := []types.Object{
+		priorModel.Sunday, priorModel.Monday, priorModel.Tuesday, priorModel.Wednesday,
+		priorModel.Thursday, priorModel.Friday, priorModel.Saturday,
+	}
 
-func getTfIntervalFromApi(ctx context.Context, interval zendesk.ScheduleInterval, dayOffset int) (intervalObj types.Object, diags diag.Diagnostics) {
+	var intervalsModel ScheduleIntervalObjectModel
 
-	if interval.StartTime >= dayOffset*24*60 && interval.StartTime < 24*60+(dayOffset*24*60) {
-		intervalModel := ScheduleIntervalModel{
-			StartTime: types.Int64Value(int64((interval.StartTime / 60) - (dayOffset * 24))),
-			EndTime:   types.Int64Value(int64((interval.EndTime / 60) - (dayOffset * 24))),
+	for dayOffset, day := range intervalsModel.weekdays() {
+		if priorDays[dayOffset].IsNull() || priorDays[dayOffset].IsUnknown() {
+			*day = types.ListNull(scheduleDayListType.ElemType)
+			continue
 		}
-		intervalObj, diags = types.ObjectValueFrom(ctx, intervalModel.AttributeTypes(), intervalModel)
+
+		var priorInterval ScheduleIntervalModelV0
+
+		diags.Append(priorDays[dayOffset].As(ctx, &priorInterval, basetypes.ObjectAsOptions{})...)
 
 		if diags.HasError() {
-			return intervalObj, diags
+			return intervals, diags
 		}
-	} else {
-		intervalObj = types.ObjectNull(ScheduleIntervalModel{}.AttributeTypes())
+
+		interval := ScheduleIntervalModel{
+			StartTime: TimeOfDayValue{StringValue: types.StringValue(strconv.FormatInt(priorInterval.StartTime.ValueInt64(), 10))},
+			EndTime:   TimeOfDayValue{StringValue: types.StringValue(strconv.FormatInt(priorInterval.EndTime.ValueInt64(), 10))},
+		}
+
+		var dayDiags diag.Diagnostics
+		*day, dayDiags = types.ListValueFrom(ctx, scheduleDayListType.ElemType, []ScheduleIntervalModel{interval})
+		diags.Append(dayDiags...)
+	}
+
+	if diags.HasError() {
+		return intervals, diags
 	}
 
-	return intervalObj, diags
+	return types.ObjectValueFrom(ctx, intervalsModel.AttributeTypes(), intervalsModel)
 }
@@ -13,13 +13,25 @@ var testScheduleName = "test Schedule"
 var testTimeZone = "Pacific Time (US & Canada)"
 
 var testIntervalTf = ScheduleIntervalModel{
+	StartTime: NewTimeOfDayValue(5 * 60),
+	EndTime:   NewTimeOfDayValue(13 * 60),
+}
+
+var testSecondIntervalTf = ScheduleIntervalModel{
+	StartTime: NewTimeOfDayValue(14 * 60),
+	EndTime:   NewTimeOfDayValue(18 * 60),
+}
+
+var testIntervalTfV0 = ScheduleIntervalModelV0{
 	StartTime: types.Int64Value(5),
 	EndTime:   types.Int64Value(13),
 }
 
-var testDayObj, _ = types.ObjectValueFrom(context.Background(), testIntervalTf.AttributeTypes(), testIntervalTf)
+var testDayObj, _ = types.ListValueFrom(context.Background(), scheduleDayListType.ElemType, []ScheduleIntervalModel{testIntervalTf})
 
-var emptyDayObj = types.ObjectNull(ScheduleIntervalModel{}.AttributeTypes())
+var testSplitDayObj, _ = types.ListValueFrom(context.Background(), scheduleDayListType.ElemType, []ScheduleIntervalModel{testIntervalTf, testSecondIntervalTf})
+
+var emptyDayObj = types.ListNull(scheduleDayListType.ElemType)
 
 var testTfIntervalsSundayOnly = ScheduleIntervalObjectModel{
 	Sunday:    testDayObj,
@@ -41,6 +53,32 @@ var testTfIntervalsAllDays = ScheduleIntervalObjectModel{
 	Saturday:  testDayObj,
 }
 
+var testTfIntervalsSplitMonday = ScheduleIntervalObjectModel{
+	Sunday:    emptyDayObj,
+	Monday:    testSplitDayObj,
+	Tuesday:   emptyDayObj,
+	Wednesday: emptyDayObj,
+	Thursday:  emptyDayObj,
+	Friday:    emptyDayObj,
+	Saturday:  emptyDayObj,
+}
+
+var testHalfHourDayObj, _ = types.ListValueFrom(context.Background(), scheduleDayListType.ElemType, []ScheduleIntervalModel{{
+	StartTime: TimeOfDayValue{StringValue: types.StringValue("08:30")},
+	EndTime:   TimeOfDayValue{StringValue: types.StringValue("17")},
+}})
+
+var intervalsObjHalfHourSunday, _ = types.ObjectValueFrom(context.Background(), testTfIntervalsSundayOnly.AttributeTypes(), ScheduleIntervalObjectModel{
+	Sunday:    testHalfHourDayObj,
+	Monday:    emptyDayObj,
+	Tuesday:   emptyDayObj,
+	Wednesday: emptyDayObj,
+	Thursday:  emptyDayObj,
+	Friday:    emptyDayObj,
+	Saturday:  emptyDayObj,
+})
+
+var intervalsObjSplitMonday, _ = types.ObjectValueFrom(context.Background(), testTfIntervalsSplitMonday.AttributeTypes(), testTfIntervalsSplitMonday)
 var intervalsObjOneDay, _ = types.ObjectValueFrom(context.Background(), testTfIntervalsSundayOnly.AttributeTypes(), testTfIntervalsSundayOnly)
 var intervalsObjAllDays, _ = types.ObjectValueFrom(context.Background(), testTfIntervalsAllDays.AttributeTypes(), testTfIntervalsAllDays)
 
@@ -140,6 +178,17 @@ var testApiScheduleInputAllDays = zendesk.Schedule{
 	UpdatedAt: &testUpdatedAt,
 }
 
+var testApiIntervalsSplitMonday = []zendesk.ScheduleInterval{
+	{
+		StartTime: 5*60 + (1 * 60 * 24),
+		EndTime:   13*60 + (1 * 60 * 24),
+	},
+	{
+		StartTime: 14*60 + (1 * 60 * 24),
+		EndTime:   18*60 + (1 * 60 * 24),
+	},
+}
+
 var testScheduleResourceInputOneDay = ScheduleResourceModel{
 	Name:      types.StringValue(testScheduleName),
 	TimeZone:  types.StringValue(testTimeZone),
@@ -184,6 +233,30 @@ func TestScheduleResourceModel_GetApiModelFromTfModel(t *testing.T) {
 			"should get api model with all days",
 			testScheduleResourceInputAllDays,
 			testApiScheduleExpectedAllDays,
+		}, {
+			"should get api model with half hour and legacy hour times",
+			ScheduleResourceModel{
+				Name:      types.StringValue(testScheduleName),
+				TimeZone:  types.StringValue(testTimeZone),
+				Intervals: intervalsObjHalfHourSunday,
+			},
+			zendesk.Schedule{
+				Intervals: []zendesk.ScheduleInterval{{StartTime: 8*60 + 30, EndTime: 17 * 60}},
+				Name:      testScheduleName,
+				TimeZone:  testTimeZone,
+			},
+		}, {
+			"should get api model with several intervals on one day",
+			ScheduleResourceModel{
+				Name:      types.StringValue(testScheduleName),
+				TimeZone:  types.StringValue(testTimeZone),
+				Intervals: intervalsObjSplitMonday,
+			},
+			zendesk.Schedule{
+				Intervals: testApiIntervalsSplitMonday,
+				Name:      testScheduleName,
+				TimeZone:  testTimeZone,
+			},
 		},
 	}
 
@@ -219,6 +292,26 @@ func TestScheduleResourceModel_GetTfModelFromApiModel(t *testing.T) {
 			testApiScheduleInputAllDays,
 			testScheduleResourceExpectedAllDays,
 		},
+		{
+			"should get tf model with several intervals on one day",
+			ScheduleResourceModel{},
+			zendesk.Schedule{
+				Id:        testId,
+				Intervals: testApiIntervalsSplitMonday,
+				Name:      testScheduleName,
+				TimeZone:  testTimeZone,
+				CreatedAt: &testCreatedAt,
+				UpdatedAt: &testUpdatedAt,
+			},
+			ScheduleResourceModel{
+				ID:        types.Int64Value(testId),
+				Name:      types.StringValue(testScheduleName),
+				TimeZone:  types.StringValue(testTimeZone),
+				Intervals: intervalsObjSplitMonday,
+				CreatedAt: types.StringValue(testCreatedAt.UTC().String()),
+				UpdatedAt: types.StringValue(testUpdatedAt.UTC().String()),
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -233,3 +326,65 @@ func TestScheduleResourceModel_GetTfModelFromApiModel(t *testing.T) {
 		})
 	}
 }
+
+func TestUpgradeScheduleIntervalsV1(t *testing.T) {
+	priorDayObj, _ := types.ObjectValueFrom(context.Background(), testIntervalTfV0.AttributeTypes(), testIntervalTfV0)
+	priorEmptyDayObj := types.ObjectNull(ScheduleIntervalModelV0{}.AttributeTypes())
+
+	priorIntervals := ScheduleIntervalObjectModelV0{
+		Sunday:    priorDayObj,
+		Monday:    priorEmptyDayObj,
+		Tuesday:   priorEmptyDayObj,
+		Wednesday: priorEmptyDayObj,
+		Thursday:  priorEmptyDayObj,
+		Friday:    priorEmptyDayObj,
+		Saturday:  priorEmptyDayObj,
+	}
+	priorIntervalsObj, _ := types.ObjectValueFrom(context.Background(), priorIntervals.AttributeTypes(), priorIntervals)
+
+	// hours are kept as configured, they are semantically equal to the HH:MM form
+	dayObj, _ := types.ListValueFrom(context.Background(), scheduleDayListType.ElemType, []ScheduleIntervalModel{{
+		StartTime: TimeOfDayValue{StringValue: types.StringValue("5")},
+		EndTime:   TimeOfDayValue{StringValue: types.StringValue("13")},
+	}})
+
+	expectedIntervals := ScheduleIntervalObjectModel{
+		Sunday:    dayObj,
+		Monday:    emptyDayObj,
+		Tuesday:   emptyDayObj,
+		Wednesday: emptyDayObj,
+		Thursday:  emptyDayObj,
+		Friday:    emptyDayObj,
+		Saturday:  emptyDayObj,
+	}
+	expectedIntervalsObj, _ := types.ObjectValueFrom(context.Background(), expectedIntervals.AttributeTypes(), expectedIntervals)
+
+	cases := []struct {
+		testName string
+		input    types.Object
+		expected types.Object
+	}{
+		{
+			"should upgrade single intervals to lists of times of day",
+			priorIntervalsObj,
+			expectedIntervalsObj,
+		},
+		{
+			"should keep null intervals",
+			types.ObjectNull(ScheduleIntervalObjectModelV0{}.AttributeTypes()),
+			types.ObjectNull(ScheduleIntervalObjectModel{}.AttributeTypes()),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(t *testing.T) {
+			out, diags := UpgradeScheduleIntervalsV1(t.Context(), tc.input)
+			if diags.HasError() {
+				t.Fatalf("UpgradeScheduleIntervalsV1() got error: %v", diags.Errors())
+			}
+			if !out.Equal(tc.expected) {
+				t.Fatalf(errorOutputMismatch, tc.testName, out, tc.expected)
+			}
+		})
+	}
+}
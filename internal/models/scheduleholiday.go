@@ -0,0 +1,48 @@
+package models
+
+import (
+	"context"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ResourceTransformWithID[zendeskapi.ScheduleHoliday] = &ScheduleHolidayResourceModel{}
+
+// ScheduleHolidayResourceModel is struct for schedule holiday payload
+// https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#holidays
+type ScheduleHolidayResourceModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	ScheduleID types.Int64  `tfsdk:"schedule_id"`
+	Name       types.String `tfsdk:"name"`
+	StartDate  types.String `tfsdk:"start_date"`
+	EndDate    types.String `tfsdk:"end_date"`
+}
+
+func (h *ScheduleHolidayResourceModel) GetID() int64 {
+	return h.ID.ValueInt64()
+}
+
+func (h *ScheduleHolidayResourceModel) GetApiModelFromTfModel(_ context.Context) (holiday zendeskapi.ScheduleHoliday, diags diag.Diagnostics) {
+	holiday = zendeskapi.ScheduleHoliday{
+		Name:      h.Name.ValueString(),
+		StartDate: h.StartDate.ValueString(),
+		EndDate:   h.EndDate.ValueString(),
+	}
+
+	return holiday, diags
+}
+
+// GetTfModelFromApiModel keeps the schedule ID, holidays returned by the API don't include it.
+func (h *ScheduleHolidayResourceModel) GetTfModelFromApiModel(_ context.Context, holiday zendeskapi.ScheduleHoliday) (diags diag.Diagnostics) {
+	*h = ScheduleHolidayResourceModel{
+		ID:         types.Int64Value(holiday.ID),
+		ScheduleID: h.ScheduleID,
+		Name:       types.StringValue(holiday.Name),
+		StartDate:  types.StringValue(holiday.StartDate),
+		EndDate:    types.StringValue(holiday.EndDate),
+	}
+
+	return diags
+}
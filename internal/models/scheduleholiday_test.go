@@ -0,0 +1,79 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestScheduleHolidayResourceModel_GetApiModelFromTfModel(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    ScheduleHolidayResourceModel
+		expected zendeskapi.ScheduleHoliday
+	}{
+		{
+			testName: "should get a api model from a tf resource",
+			input: ScheduleHolidayResourceModel{
+				ScheduleID: types.Int64Value(testId),
+				Name:       types.StringValue(testTitle),
+				StartDate:  types.StringValue("2025-12-24"),
+				EndDate:    types.StringValue("2025-12-26"),
+			},
+			expected: zendeskapi.ScheduleHoliday{
+				Name:      testTitle,
+				StartDate: "2025-12-24",
+				EndDate:   "2025-12-26",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			out, _ := c.input.GetApiModelFromTfModel(t.Context())
+			if !reflect.DeepEqual(out, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, c.expected)
+			}
+		})
+	}
+}
+
+func TestScheduleHolidayResourceModel_GetTfModelFromApiModel(t *testing.T) {
+	cases := []struct {
+		testName string
+		target   ScheduleHolidayResourceModel
+		input    zendeskapi.ScheduleHoliday
+		expected ScheduleHolidayResourceModel
+	}{
+		{
+			testName: "should generate TF resource model from api model, keeping the schedule id",
+			target: ScheduleHolidayResourceModel{
+				ScheduleID: types.Int64Value(testId),
+			},
+			input: zendeskapi.ScheduleHoliday{
+				ID:        2,
+				Name:      testTitle,
+				StartDate: "2025-12-24",
+				EndDate:   "2025-12-26",
+			},
+			expected: ScheduleHolidayResourceModel{
+				ID:         types.Int64Value(2),
+				ScheduleID: types.Int64Value(testId),
+				Name:       types.StringValue(testTitle),
+				StartDate:  types.StringValue("2025-12-24"),
+				EndDate:    types.StringValue("2025-12-26"),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			c.target.GetTfModelFromApiModel(t.Context(), c.input)
+			if !reflect.DeepEqual(c.target, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, c.target, c.expected)
+			}
+		})
+	}
+}
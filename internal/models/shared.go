@@ -2,6 +2,10 @@ package models
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
 	"github.com/JacobPotter/go-zendesk/zendesk"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -102,6 +106,54 @@ func getApiRelationshipObjectsFromTf(tfObjs []RelationshipFilterObjectResourceMo
 	return filterObjs
 }
 
+// getTfRelationshipFilterFromApi returns a null object for lookup fields without a filter.
+func getTfRelationshipFilterFromApi(ctx context.Context, relationshipFilter zendesk.RelationshipFilter) (tfRelationshipFilterObject types.Object, diags diag.Diagnostics) {
+	if len(relationshipFilter.All) == 0 && len(relationshipFilter.Any) == 0 {
+		return types.ObjectNull(RelationshipFilterResourceModel{}.AttributeTypes()), diags
+	}
+
+	tfAllRelationshipFilterList, diags := getTfRelationshipFiltersObjectsFromApi(ctx, relationshipFilter.All)
+
+	if diags.HasError() {
+		return tfRelationshipFilterObject, diags
+	}
+
+	tfAnyRelationshipFilterList, diags := getTfRelationshipFiltersObjectsFromApi(ctx, relationshipFilter.Any)
+
+	if diags.HasError() {
+		return tfRelationshipFilterObject, diags
+	}
+
+	tfRelationshipFilter := RelationshipFilterResourceModel{
+		All: tfAllRelationshipFilterList,
+		Any: tfAnyRelationshipFilterList,
+	}
+
+	return types.ObjectValueFrom(ctx, tfRelationshipFilter.AttributeTypes(), tfRelationshipFilter)
+}
+
+func getTfRelationshipFiltersObjectsFromApi(ctx context.Context, relationshipFilterObjects []zendesk.RelationshipFilterObject) (filterList types.List, diags diag.Diagnostics) {
+	if len(relationshipFilterObjects) == 0 {
+		return types.ListNull(types.ObjectType{AttrTypes: RelationshipFilterObjectResourceModel{}.AttributeTypes()}), diags
+	}
+
+	filterObjectResourceModels := make([]RelationshipFilterObjectResourceModel, len(relationshipFilterObjects))
+
+	for i, object := range relationshipFilterObjects {
+		filterObjectResourceModels[i] = RelationshipFilterObjectResourceModel{
+			Field:    types.StringValue(object.Field),
+			Operator: types.StringValue(object.Operator),
+			Value:    types.StringValue(object.Value),
+		}
+	}
+
+	return types.ListValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: RelationshipFilterObjectResourceModel{}.AttributeTypes()},
+		filterObjectResourceModels,
+	)
+}
+
 func getTfCustomFieldOptionsFromApi(ctx context.Context, options []zendesk.CustomFieldOption) (tfCustomOptionsList types.List, diags diag.Diagnostics) {
 
 	if len(options) > 0 {
@@ -152,3 +204,103 @@ func (c CustomFieldOptionResourceModel) AttributeTypes() map[string]attr.Type {
 		"value": types.StringType,
 	}
 }
+
+// getApiCustomFieldValuesFromTf converts user or organization field values keyed by field key,
+// unknown values are left out of the request.
+func getApiCustomFieldValuesFromTf(ctx context.Context, tfValues types.Map) (values map[string]interface{}, diags diag.Diagnostics) {
+	if tfValues.IsNull() || tfValues.IsUnknown() {
+		return nil, diags
+	}
+
+	var fieldValues map[string]string
+
+	diags.Append(tfValues.ElementsAs(ctx, &fieldValues, false)...)
+
+	values = make(map[string]interface{}, len(fieldValues))
+	for key, value := range fieldValues {
+		values[key] = value
+	}
+
+	return values, diags
+}
+
+// getTfCustomFieldValuesFromApi keeps only the user or organization fields already in prior,
+// as Zendesk returns every field of the account. All fields with a value are kept when prior
+// has none yet, ex: on import. Zendesk stores empty values as null, a prior field without a
+// value is kept as an empty string.
+func getTfCustomFieldValuesFromApi(ctx context.Context, prior types.Map, values map[string]interface{}) (tfValues types.Map, diags diag.Diagnostics) {
+	var priorKeys map[string]attr.Value
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		priorKeys = prior.Elements()
+	}
+
+	fieldValues := make(map[string]string, len(values))
+
+	for key, value := range values {
+		if _, ok := priorKeys[key]; priorKeys != nil && !ok {
+			continue
+		}
+
+		fieldValue, ok, err := getTfCustomFieldValue(value)
+		if err != nil {
+			diags.AddError("Error converting custom field value", fmt.Sprintf("field %s: %s", key, err))
+			continue
+		}
+
+		if ok || priorKeys != nil {
+			fieldValues[key] = fieldValue
+		}
+	}
+
+	if diags.HasError() {
+		return tfValues, diags
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, fieldValues)
+}
+
+// getTfCustomFieldValue formats a user or organization field value as a string, ex: true for
+// checkbox fields. ok is false for fields without a value.
+func getTfCustomFieldValue(value interface{}) (fieldValue string, ok bool, err error) {
+	switch v := value.(type) {
+	case nil:
+		return "", false, nil
+	case string:
+		return v, true, nil
+	case bool:
+		return strconv.FormatBool(v), true, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true, nil
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", false, err
+		}
+		return string(b), true, nil
+	}
+}
+
+// nonNilStrings turns a nil slice into an empty one, so empty lists and sets aren't null.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// int64ValueOrNull returns a null value for IDs Zendesk leaves unset, which decode as 0.
+func int64ValueOrNull(id int64) types.Int64 {
+	if id == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(id)
+}
+
+// stringValueOrNull returns a null value for optional strings Zendesk leaves empty.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
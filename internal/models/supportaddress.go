@@ -0,0 +1,87 @@
+package models
+
+import (
+	"context"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SupportAddressDefaultVerificationTimeout is how long forwarding verification is waited for
+// when no timeout is configured.
+const SupportAddressDefaultVerificationTimeout = "10m"
+
+var _ ResourceTransformWithID[zendeskapi.SupportAddress] = &SupportAddressResourceModel{}
+
+// SupportAddressResourceModel is struct for support address payload
+// https://developer.zendesk.com/api-reference/ticketing/account-configuration/support_addresses/
+type SupportAddressResourceModel struct {
+	ID                       types.Int64  `tfsdk:"id"`
+	Email                    types.String `tfsdk:"email"`
+	Name                     types.String `tfsdk:"name"`
+	BrandID                  types.Int64  `tfsdk:"brand_id"`
+	Default                  types.Bool   `tfsdk:"default"`
+	ForwardingStatus         types.String `tfsdk:"forwarding_status"`
+	SPFStatus                types.String `tfsdk:"spf_status"`
+	CNAMEStatus              types.String `tfsdk:"cname_status"`
+	DomainVerificationStatus types.String `tfsdk:"domain_verification_status"`
+	WaitForVerification      types.Bool   `tfsdk:"wait_for_verification"`
+	VerificationTimeout      types.String `tfsdk:"verification_timeout"`
+	CreatedAt                types.String `tfsdk:"created_at"`
+	UpdatedAt                types.String `tfsdk:"updated_at"`
+}
+
+func (a *SupportAddressResourceModel) GetID() int64 {
+	return a.ID.ValueInt64()
+}
+
+func (a *SupportAddressResourceModel) GetApiModelFromTfModel(_ context.Context) (address zendeskapi.SupportAddress, diags diag.Diagnostics) {
+	address = zendeskapi.SupportAddress{
+		Email:   a.Email.ValueString(),
+		Name:    a.Name.ValueString(),
+		BrandID: a.BrandID.ValueInt64(),
+		Default: a.Default.ValueBool(),
+	}
+
+	return address, diags
+}
+
+// GetTfModelFromApiModel keeps the verification settings, which are only known to Terraform.
+func (a *SupportAddressResourceModel) GetTfModelFromApiModel(_ context.Context, address zendeskapi.SupportAddress) (diags diag.Diagnostics) {
+	waitForVerification := a.WaitForVerification
+	if waitForVerification.IsNull() || waitForVerification.IsUnknown() {
+		waitForVerification = types.BoolValue(false)
+	}
+
+	verificationTimeout := a.VerificationTimeout
+	if verificationTimeout.IsNull() || verificationTimeout.IsUnknown() {
+		verificationTimeout = types.StringValue(SupportAddressDefaultVerificationTimeout)
+	}
+
+	*a = SupportAddressResourceModel{
+		ID:                       types.Int64Value(address.ID),
+		Email:                    types.StringValue(address.Email),
+		Name:                     types.StringValue(address.Name),
+		BrandID:                  types.Int64Value(address.BrandID),
+		Default:                  types.BoolValue(address.Default),
+		ForwardingStatus:         types.StringValue(address.ForwardingStatus),
+		SPFStatus:                types.StringValue(address.SPFStatus),
+		CNAMEStatus:              types.StringValue(address.CNAMEStatus),
+		DomainVerificationStatus: types.StringValue(address.DomainVerificationStatus),
+		WaitForVerification:      waitForVerification,
+		VerificationTimeout:      verificationTimeout,
+		CreatedAt:                types.StringNull(),
+		UpdatedAt:                types.StringNull(),
+	}
+
+	if address.CreatedAt != nil {
+		a.CreatedAt = types.StringValue(address.CreatedAt.UTC().String())
+	}
+
+	if address.UpdatedAt != nil {
+		a.UpdatedAt = types.StringValue(address.UpdatedAt.UTC().String())
+	}
+
+	return diags
+}
@@ -0,0 +1,114 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSupportAddressResourceModel_GetApiModelFromTfModel(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    SupportAddressResourceModel
+		expected zendeskapi.SupportAddress
+	}{
+		{
+			testName: "should get a api model from a tf resource",
+			input: SupportAddressResourceModel{
+				Email:               types.StringValue("help@example.com"),
+				Name:                types.StringValue(testTitle),
+				BrandID:             types.Int64Value(testId),
+				Default:             types.BoolUnknown(),
+				WaitForVerification: types.BoolValue(true),
+				VerificationTimeout: types.StringValue("5m"),
+			},
+			expected: zendeskapi.SupportAddress{
+				Email:   "help@example.com",
+				Name:    testTitle,
+				BrandID: testId,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			out, _ := c.input.GetApiModelFromTfModel(t.Context())
+			if !reflect.DeepEqual(out, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, c.expected)
+			}
+		})
+	}
+}
+
+func TestSupportAddressResourceModel_GetTfModelFromApiModel(t *testing.T) {
+	address := zendeskapi.SupportAddress{
+		ID:                       testId,
+		Email:                    "help@example.com",
+		Name:                     testTitle,
+		BrandID:                  2,
+		Default:                  true,
+		ForwardingStatus:         "verified",
+		SPFStatus:                "verified",
+		CNAMEStatus:              "unknown",
+		DomainVerificationStatus: "verified",
+		CreatedAt:                &testCreatedAt,
+		UpdatedAt:                &testUpdatedAt,
+	}
+
+	expected := SupportAddressResourceModel{
+		ID:                       types.Int64Value(testId),
+		Email:                    types.StringValue("help@example.com"),
+		Name:                     types.StringValue(testTitle),
+		BrandID:                  types.Int64Value(2),
+		Default:                  types.BoolValue(true),
+		ForwardingStatus:         types.StringValue("verified"),
+		SPFStatus:                types.StringValue("verified"),
+		CNAMEStatus:              types.StringValue("unknown"),
+		DomainVerificationStatus: types.StringValue("verified"),
+		CreatedAt:                types.StringValue(testCreatedAt.UTC().String()),
+		UpdatedAt:                types.StringValue(testUpdatedAt.UTC().String()),
+	}
+
+	cases := []struct {
+		testName            string
+		waitForVerification types.Bool
+		verificationTimeout types.String
+		expectedWait        types.Bool
+		expectedTimeout     types.String
+	}{
+		{
+			testName:            "should keep the verification settings",
+			waitForVerification: types.BoolValue(true),
+			verificationTimeout: types.StringValue("5m"),
+			expectedWait:        types.BoolValue(true),
+			expectedTimeout:     types.StringValue("5m"),
+		},
+		{
+			testName:            "should default the verification settings on import",
+			waitForVerification: types.BoolNull(),
+			verificationTimeout: types.StringNull(),
+			expectedWait:        types.BoolValue(false),
+			expectedTimeout:     types.StringValue(SupportAddressDefaultVerificationTimeout),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			out := SupportAddressResourceModel{
+				WaitForVerification: c.waitForVerification,
+				VerificationTimeout: c.verificationTimeout,
+			}
+			out.GetTfModelFromApiModel(t.Context(), address)
+
+			caseExpected := expected
+			caseExpected.WaitForVerification = c.expectedWait
+			caseExpected.VerificationTimeout = c.expectedTimeout
+
+			if !reflect.DeepEqual(out, caseExpected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, caseExpected)
+			}
+		})
+	}
+}
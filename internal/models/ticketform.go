@@ -8,25 +8,28 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"slices"
 	"strconv"
 )
 
 var _ ResourceTransformWithID[zendesk.TicketForm] = &TicketFormResourceModel{}
 
 type TicketFormResourceModel struct {
-	ID                types.Int64  `tfsdk:"id"`
-	Name              types.String `tfsdk:"form_name"`
-	DisplayName       types.String `tfsdk:"end_user_display_name"`
-	TicketFieldIds    types.List   `tfsdk:"ticket_field_ids"`
-	AgentConditions   types.Map    `tfsdk:"agent_conditions"`
-	EndUserConditions types.Map    `tfsdk:"end_user_conditions"`
-	Active            types.Bool   `tfsdk:"active"`
-	Position          types.Int64  `tfsdk:"position"`
-	Default           types.Bool   `tfsdk:"default"`
-	EndUserVisible    types.Bool   `tfsdk:"end_user_visible"`
-	CreatedAt         types.String `tfsdk:"created_at"`
-	UpdatedAt         types.String `tfsdk:"updated_at"`
-	Url               types.String `tfsdk:"url"`
+	ID                 types.Int64  `tfsdk:"id"`
+	Name               types.String `tfsdk:"form_name"`
+	DisplayName        types.String `tfsdk:"end_user_display_name"`
+	TicketFieldIds     types.List   `tfsdk:"ticket_field_ids"`
+	AgentConditions    types.Map    `tfsdk:"agent_conditions"`
+	EndUserConditions  types.Map    `tfsdk:"end_user_conditions"`
+	Active             types.Bool   `tfsdk:"active"`
+	Position           types.Int64  `tfsdk:"position"`
+	Default            types.Bool   `tfsdk:"default"`
+	EndUserVisible     types.Bool   `tfsdk:"end_user_visible"`
+	InAllBrands        types.Bool   `tfsdk:"in_all_brands"`
+	RestrictedBrandIds types.Set    `tfsdk:"restricted_brand_ids"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+	Url                types.String `tfsdk:"url"`
 }
 
 type TicketFormResourceModelV0 struct {
@@ -135,9 +138,29 @@ func (t *TicketFormResourceModel) GetApiModelFromTfModel(ctx context.Context) (f
 		form.Position = t.Position.ValueInt64()
 	}
 
+	// a form restricted to brands is not in all brands, an unknown brand restriction keeps the
+	// one set in Zendesk
+	if !t.RestrictedBrandIds.IsUnknown() && len(t.RestrictedBrandIds.Elements()) > 0 {
+		form.RestrictedBrandIds = make([]int64, 0, len(t.RestrictedBrandIds.Elements()))
+		diags.Append(t.RestrictedBrandIds.ElementsAs(ctx, &form.RestrictedBrandIds, false)...)
+		slices.Sort(form.RestrictedBrandIds)
+	} else if !t.InAllBrands.IsUnknown() && !t.InAllBrands.IsNull() {
+		form.InAllBrands = t.InAllBrands.ValueBool()
+	}
+
 	return form, diags
 }
 
+// GetInAllBrands returns the configured in_all_brands, nil when it is not set. The API model can't
+// tell false from unset.
+func (t *TicketFormResourceModel) GetInAllBrands() *bool {
+	if t.InAllBrands.IsUnknown() || t.InAllBrands.IsNull() {
+		return nil
+	}
+
+	return t.InAllBrands.ValueBoolPointer()
+}
+
 func getApiFormConditionsFromTf(ctx context.Context, conditionsMap types.Map) (apiConditions []zendesk.ConditionalTicketField, diags diag.Diagnostics) {
 
 	diags = diag.Diagnostics{}
@@ -267,6 +290,20 @@ func (t *TicketFormResourceModel) GetTfModelFromApiModel(ctx context.Context, ap
 		return diags
 	}
 
+	// forms available in all brands have no brand restriction
+	restrictedBrandSet := types.SetNull(types.Int64Type)
+
+	if len(apiModel.RestrictedBrandIds) > 0 {
+		restrictedBrandIds := slices.Clone(apiModel.RestrictedBrandIds)
+		slices.Sort(restrictedBrandIds)
+
+		restrictedBrandSet, diags = types.SetValueFrom(ctx, types.Int64Type, restrictedBrandIds)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	var displayName types.String
 
 	if apiModel.DisplayName != "" {
@@ -276,19 +313,21 @@ func (t *TicketFormResourceModel) GetTfModelFromApiModel(ctx context.Context, ap
 	}
 
 	*t = TicketFormResourceModel{
-		ID:                types.Int64Value(apiModel.ID),
-		Name:              types.StringValue(apiModel.Name),
-		DisplayName:       displayName,
-		TicketFieldIds:    fieldList,
-		AgentConditions:   agentConditions,
-		EndUserConditions: endUserConditions,
-		Active:            types.BoolValue(apiModel.Active),
-		Position:          types.Int64Value(apiModel.Position),
-		Default:           types.BoolValue(apiModel.Default),
-		EndUserVisible:    types.BoolValue(apiModel.EndUserVisible),
-		CreatedAt:         types.StringValue(apiModel.CreatedAt.UTC().String()),
-		UpdatedAt:         types.StringValue(apiModel.UpdatedAt.UTC().String()),
-		Url:               types.StringValue(apiModel.Url),
+		ID:                 types.Int64Value(apiModel.ID),
+		Name:               types.StringValue(apiModel.Name),
+		DisplayName:        displayName,
+		TicketFieldIds:     fieldList,
+		AgentConditions:    agentConditions,
+		EndUserConditions:  endUserConditions,
+		Active:             types.BoolValue(apiModel.Active),
+		Position:           types.Int64Value(apiModel.Position),
+		Default:            types.BoolValue(apiModel.Default),
+		EndUserVisible:     types.BoolValue(apiModel.EndUserVisible),
+		InAllBrands:        types.BoolValue(apiModel.InAllBrands),
+		RestrictedBrandIds: restrictedBrandSet,
+		CreatedAt:          types.StringValue(apiModel.CreatedAt.UTC().String()),
+		UpdatedAt:          types.StringValue(apiModel.UpdatedAt.UTC().String()),
+		Url:                types.StringValue(apiModel.Url),
 	}
 	return diags
 }
@@ -24,12 +24,18 @@ func TestTicketFormResourceModel(t *testing.T) {
 			}),
 			AgentConditions:   types.MapNull(types.ObjectType{AttrTypes: FormConditions{}.AttributeTypes()}),
 			EndUserConditions: types.MapNull(types.ObjectType{AttrTypes: FormConditions{}.AttributeTypes()}),
+			InAllBrands:       types.BoolValue(false),
+			RestrictedBrandIds: types.SetValueMust(types.Int64Type, []attr.Value{
+				types.Int64Value(3),
+				types.Int64Value(1),
+			}),
 		}
 		testTicketFormSimpleExpected = zendesk.TicketForm{
-			Name:              testTitle,
-			TicketFieldIds:    []int64{12345, 56789},
-			AgentConditions:   []zendesk.ConditionalTicketField(nil),
-			EndUserConditions: []zendesk.ConditionalTicketField(nil),
+			Name:               testTitle,
+			TicketFieldIds:     []int64{12345, 56789},
+			AgentConditions:    []zendesk.ConditionalTicketField(nil),
+			EndUserConditions:  []zendesk.ConditionalTicketField(nil),
+			RestrictedBrandIds: []int64{1, 3},
 		}
 		testRequiredOnStatusResourceModel = RequiredOnStatusesResourceModel{
 			Statuses: types.ListValueMust(types.StringType, []attr.Value{
@@ -102,11 +108,14 @@ func TestTicketFormResourceModel(t *testing.T) {
 				types.Int64Value(12345),
 				types.Int64Value(56789),
 			}),
-			AgentConditions:   testAgentConditions,
-			EndUserConditions: types.MapNull(types.ObjectType{AttrTypes: FormConditionsSet{}.AttributeTypes()}),
+			AgentConditions:    testAgentConditions,
+			EndUserConditions:  types.MapNull(types.ObjectType{AttrTypes: FormConditionsSet{}.AttributeTypes()}),
+			InAllBrands:        types.BoolValue(true),
+			RestrictedBrandIds: types.SetValueMust(types.Int64Type, []attr.Value{}),
 		}
 		testTicketFormConditionsExpected = zendesk.TicketForm{
 			Name:           testTitle,
+			InAllBrands:    true,
 			TicketFieldIds: []int64{12345, 56789},
 			AgentConditions: []zendesk.ConditionalTicketField{
 				{
@@ -128,19 +137,20 @@ func TestTicketFormResourceModel(t *testing.T) {
 		}
 
 		testTicketFormSimpleInput = zendesk.TicketForm{
-			Active:            true,
-			AgentConditions:   nil,
-			CreatedAt:         testCreatedAt,
-			Default:           false,
-			DisplayName:       testTitle,
-			EndUserConditions: nil,
-			EndUserVisible:    false,
-			ID:                testId,
-			Name:              testTitle,
-			Position:          testPosition,
-			TicketFieldIds:    []int64{12345, 56789},
-			UpdatedAt:         testUpdatedAt,
-			Url:               testUrl,
+			Active:             true,
+			AgentConditions:    nil,
+			CreatedAt:          testCreatedAt,
+			Default:            false,
+			DisplayName:        testTitle,
+			EndUserConditions:  nil,
+			EndUserVisible:     false,
+			ID:                 testId,
+			Name:               testTitle,
+			Position:           testPosition,
+			RestrictedBrandIds: []int64{3, 1},
+			TicketFieldIds:     []int64{12345, 56789},
+			UpdatedAt:          testUpdatedAt,
+			Url:                testUrl,
 		}
 
 		testTicketFormResourceModelSimpleExpected = TicketFormResourceModel{
@@ -157,9 +167,14 @@ func TestTicketFormResourceModel(t *testing.T) {
 			Position:          types.Int64Value(testPosition),
 			Default:           types.BoolValue(false),
 			EndUserVisible:    types.BoolValue(false),
-			CreatedAt:         types.StringValue(testCreatedAt.UTC().String()),
-			UpdatedAt:         types.StringValue(testUpdatedAt.UTC().String()),
-			Url:               types.StringValue(testUrl),
+			InAllBrands:       types.BoolValue(false),
+			RestrictedBrandIds: types.SetValueMust(types.Int64Type, []attr.Value{
+				types.Int64Value(1),
+				types.Int64Value(3),
+			}),
+			CreatedAt: types.StringValue(testCreatedAt.UTC().String()),
+			UpdatedAt: types.StringValue(testUpdatedAt.UTC().String()),
+			Url:       types.StringValue(testUrl),
 		}
 
 		testTicketFormConditionsInput = zendesk.TicketForm{
@@ -186,6 +201,7 @@ func TestTicketFormResourceModel(t *testing.T) {
 			DisplayName:       testTitle,
 			EndUserVisible:    false,
 			ID:                testId,
+			InAllBrands:       true,
 			Name:              testTitle,
 			Position:          testPosition,
 			TicketFieldIds:    []int64{12345, 56789},
@@ -201,15 +217,17 @@ func TestTicketFormResourceModel(t *testing.T) {
 				types.Int64Value(12345),
 				types.Int64Value(56789),
 			}),
-			AgentConditions:   testAgentConditions,
-			EndUserConditions: types.MapNull(types.ObjectType{AttrTypes: FormConditionsSet{}.AttributeTypes()}),
-			Active:            types.BoolValue(true),
-			Position:          types.Int64Value(testPosition),
-			Default:           types.BoolValue(false),
-			EndUserVisible:    types.BoolValue(false),
-			CreatedAt:         types.StringValue(testCreatedAt.UTC().String()),
-			UpdatedAt:         types.StringValue(testUpdatedAt.UTC().String()),
-			Url:               types.StringValue(testUrl),
+			AgentConditions:    testAgentConditions,
+			EndUserConditions:  types.MapNull(types.ObjectType{AttrTypes: FormConditionsSet{}.AttributeTypes()}),
+			Active:             types.BoolValue(true),
+			Position:           types.Int64Value(testPosition),
+			Default:            types.BoolValue(false),
+			EndUserVisible:     types.BoolValue(false),
+			InAllBrands:        types.BoolValue(true),
+			RestrictedBrandIds: types.SetNull(types.Int64Type),
+			CreatedAt:          types.StringValue(testCreatedAt.UTC().String()),
+			UpdatedAt:          types.StringValue(testUpdatedAt.UTC().String()),
+			Url:                types.StringValue(testUrl),
 		}
 	)
 
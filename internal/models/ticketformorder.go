@@ -0,0 +1,15 @@
+package models
+
+import "github.com/JacobPotter/go-zendesk/zendesk"
+
+// TicketFormOrderID is the ID of the ticket form order, there is one per account.
+const TicketFormOrderID = "ticket_form_order"
+
+// TicketFormIDsByPosition returns the IDs of the forms ordered by position, forms sharing a
+// position are ordered by ID.
+func TicketFormIDsByPosition(forms []zendesk.TicketForm) []int64 {
+	return idsByPosition(forms,
+		func(form zendesk.TicketForm) int64 { return form.ID },
+		func(form zendesk.TicketForm) int64 { return form.Position },
+	)
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+func TestTicketFormIDsByPosition(t *testing.T) {
+	forms := []zendesk.TicketForm{
+		{ID: 3, Position: 1},
+		{ID: 2, Position: 0},
+		{ID: 1, Position: 1},
+		{ID: 4, Position: 2},
+	}
+
+	out := TicketFormIDsByPosition(forms)
+
+	if !slices.Equal(out, []int64{2, 1, 3, 4}) {
+		t.Fatalf(errorOutputMismatch, "should order the forms by position then ID", out, []int64{2, 1, 3, 4})
+	}
+}
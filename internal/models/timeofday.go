@@ -0,0 +1,158 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = TimeOfDayType{}
+	_ basetypes.StringValuableWithSemanticEquals = TimeOfDayValue{}
+	_ xattr.ValidateableAttribute                = TimeOfDayValue{}
+)
+
+// TimeOfDayType is a time of day formatted as HH:MM, ex: 09:30, between 00:00 and 24:00.
+// Whole hours, ex: 9, are accepted as well, as schedules used hours before.
+type TimeOfDayType struct {
+	basetypes.StringType
+}
+
+func (t TimeOfDayType) String() string {
+	return "models.TimeOfDayType"
+}
+
+func (t TimeOfDayType) ValueType(_ context.Context) attr.Value {
+	return TimeOfDayValue{}
+}
+
+func (t TimeOfDayType) Equal(o attr.Type) bool {
+	other, ok := o.(TimeOfDayType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t TimeOfDayType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return TimeOfDayValue{StringValue: in}, nil
+}
+
+func (t TimeOfDayType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return TimeOfDayValue{StringValue: stringValue}, nil
+}
+
+type TimeOfDayValue struct {
+	basetypes.StringValue
+}
+
+// NewTimeOfDayValue formats minutes from the beginning of the day as HH:MM.
+func NewTimeOfDayValue(minutes int) TimeOfDayValue {
+	return TimeOfDayValue{
+		StringValue: basetypes.NewStringValue(fmt.Sprintf("%02d:%02d", minutes/minutesPerHour, minutes%minutesPerHour)),
+	}
+}
+
+func NewTimeOfDayNull() TimeOfDayValue {
+	return TimeOfDayValue{StringValue: basetypes.NewStringNull()}
+}
+
+func (v TimeOfDayValue) Type(_ context.Context) attr.Type {
+	return TimeOfDayType{}
+}
+
+func (v TimeOfDayValue) Equal(o attr.Value) bool {
+	other, ok := o.(TimeOfDayValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals keeps the configured form when the API returns the same time, ex:
+// 9 and 09:00.
+func (v TimeOfDayValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(TimeOfDayValue)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	prior, err := v.Minutes()
+	if err != nil {
+		return false, diags
+	}
+
+	current, err := newValue.Minutes()
+	if err != nil {
+		return false, diags
+	}
+
+	return prior == current, diags
+}
+
+func (v TimeOfDayValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := v.Minutes(); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid time of day", err.Error())
+	}
+}
+
+// Minutes returns the minutes from the beginning of the day.
+func (v TimeOfDayValue) Minutes() (int, error) {
+	value := v.ValueString()
+	hours, minutes, hasMinutes := strings.Cut(value, ":")
+
+	h, err := strconv.Atoi(hours)
+	if err != nil || len(hours) > 2 || (hasMinutes && len(minutes) != 2) {
+		return 0, fmt.Errorf("%q must be a time of day formatted as HH:MM, ex: 09:30", value)
+	}
+
+	m := 0
+	if hasMinutes {
+		m, err = strconv.Atoi(minutes)
+		if err != nil || m < 0 || m >= minutesPerHour {
+			return 0, fmt.Errorf("%q must be a time of day formatted as HH:MM, ex: 09:30", value)
+		}
+	}
+
+	total := h*minutesPerHour + m
+	if h < 0 || total > minutesPerDay {
+		return 0, fmt.Errorf("%q must be between 00:00 and 24:00", value)
+	}
+
+	return total, nil
+}
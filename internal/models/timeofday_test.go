@@ -0,0 +1,91 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTimeOfDayValue_Minutes(t *testing.T) {
+	cases := []struct {
+		testName  string
+		input     string
+		expected  int
+		expectErr bool
+	}{
+		{"should parse HH:MM", "09:30", 9*60 + 30, false},
+		{"should parse H:MM", "9:05", 9*60 + 5, false},
+		{"should parse whole hours", "17", 17 * 60, false},
+		{"should parse midnight", "00:00", 0, false},
+		{"should parse end of day", "24:00", 24 * 60, false},
+		{"should reject times past 24:00", "24:30", 0, true},
+		{"should reject minutes past 59", "09:60", 0, true},
+		{"should reject single digit minutes", "09:5", 0, true},
+		{"should reject negative hours", "-1:00", 0, true},
+		{"should reject minute offsets", "540", 0, true},
+		{"should reject text", "9am", 0, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(t *testing.T) {
+			out, err := TimeOfDayValue{StringValue: types.StringValue(tc.input)}.Minutes()
+			if (err != nil) != tc.expectErr {
+				t.Fatalf("Minutes() error = %v, expected error %v", err, tc.expectErr)
+			}
+			if out != tc.expected {
+				t.Fatalf(errorOutputMismatch, tc.testName, out, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNewTimeOfDayValue(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    int
+		expected string
+	}{
+		{"should format hours", 9 * 60, "09:00"},
+		{"should format minutes", 17*60 + 45, "17:45"},
+		{"should format end of day", 24 * 60, "24:00"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(t *testing.T) {
+			out := NewTimeOfDayValue(tc.input).ValueString()
+			if out != tc.expected {
+				t.Fatalf(errorOutputMismatch, tc.testName, out, tc.expected)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayValue_StringSemanticEquals(t *testing.T) {
+	cases := []struct {
+		testName string
+		prior    string
+		current  string
+		expected bool
+	}{
+		{"should equal the same time", "09:00", "09:00", true},
+		{"should equal whole hours", "9", "09:00", true},
+		{"should equal without leading zero", "9:30", "09:30", true},
+		{"should not equal another time", "09:00", "09:30", false},
+		{"should not equal an invalid time", "9am", "09:00", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(t *testing.T) {
+			prior := TimeOfDayValue{StringValue: types.StringValue(tc.prior)}
+			current := TimeOfDayValue{StringValue: types.StringValue(tc.current)}
+
+			out, diags := prior.StringSemanticEquals(t.Context(), current)
+			if diags.HasError() {
+				t.Fatalf("StringSemanticEquals() got error: %v", diags.Errors())
+			}
+			if out != tc.expected {
+				t.Fatalf(errorOutputMismatch, tc.testName, out, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package models
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TriggerOrderID is the ID of the trigger order, there is one per account.
+const TriggerOrderID = "trigger_order"
+
+// TriggerOrderResourceModel is the order of the listed trigger categories and of the listed
+// triggers inside each category.
+type TriggerOrderResourceModel struct {
+	ID         types.String                        `tfsdk:"id"`
+	Categories []TriggerOrderCategoryResourceModel `tfsdk:"categories"`
+}
+
+type TriggerOrderCategoryResourceModel struct {
+	CategoryID types.Int64 `tfsdk:"category_id"`
+	TriggerIDs types.List  `tfsdk:"trigger_ids"`
+}
+
+// TriggerCategoryOrder is a trigger category and the IDs of its triggers, in order.
+type TriggerCategoryOrder struct {
+	CategoryID int64
+	TriggerIDs []int64
+}
+
+// NewTriggerOrder returns every category ordered by position, with the triggers of each
+// category ordered by position. Categories and triggers sharing a position are ordered by ID.
+func NewTriggerOrder(categories []zendesk.TriggerCategory, triggers []zendesk.Trigger) []TriggerCategoryOrder {
+	categories = slices.Clone(categories)
+	slices.SortFunc(categories, func(a, b zendesk.TriggerCategory) int {
+		aID, _ := strconv.ParseInt(a.ID, 10, 64)
+		bID, _ := strconv.ParseInt(b.ID, 10, 64)
+		return cmp.Or(cmp.Compare(a.Position, b.Position), cmp.Compare(aID, bID))
+	})
+
+	triggers = slices.Clone(triggers)
+	slices.SortFunc(triggers, func(a, b zendesk.Trigger) int {
+		return cmp.Or(cmp.Compare(a.Position, b.Position), cmp.Compare(a.ID, b.ID))
+	})
+
+	order := make([]TriggerCategoryOrder, 0, len(categories))
+
+	for _, category := range categories {
+		categoryID, _ := strconv.ParseInt(category.ID, 10, 64)
+		categoryOrder := TriggerCategoryOrder{CategoryID: categoryID, TriggerIDs: []int64{}}
+
+		for _, trigger := range triggers {
+			if trigger.CategoryID == category.ID {
+				categoryOrder.TriggerIDs = append(categoryOrder.TriggerIDs, trigger.ID)
+			}
+		}
+
+		order = append(order, categoryOrder)
+	}
+
+	return order
+}
+
+// GetApiModelFromTfModel returns the listed categories and triggers in order.
+func (o *TriggerOrderResourceModel) GetApiModelFromTfModel(ctx context.Context) (order []TriggerCategoryOrder, diags diag.Diagnostics) {
+	order = make([]TriggerCategoryOrder, 0, len(o.Categories))
+
+	for _, category := range o.Categories {
+		categoryOrder := TriggerCategoryOrder{
+			CategoryID: category.CategoryID.ValueInt64(),
+			TriggerIDs: make([]int64, 0, len(category.TriggerIDs.Elements())),
+		}
+
+		diags.Append(category.TriggerIDs.ElementsAs(ctx, &categoryOrder.TriggerIDs, false)...)
+
+		order = append(order, categoryOrder)
+	}
+
+	return order, diags
+}
+
+// GetTfModelFromApiModel keeps only the categories and triggers already listed, in their
+// current order. A listed trigger moved to another category is kept under that category if
+// it is listed, an empty model (on import) lists every category and trigger.
+func (o *TriggerOrderResourceModel) GetTfModelFromApiModel(ctx context.Context, order []TriggerCategoryOrder) (diags diag.Diagnostics) {
+	listed, diags := o.GetApiModelFromTfModel(ctx)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	var listedCategoryIDs, listedTriggerIDs []int64
+
+	for _, category := range listed {
+		listedCategoryIDs = append(listedCategoryIDs, category.CategoryID)
+		listedTriggerIDs = append(listedTriggerIDs, category.TriggerIDs...)
+	}
+
+	categories := make([]TriggerOrderCategoryResourceModel, 0, len(order))
+
+	for _, category := range order {
+		triggerIDs := category.TriggerIDs
+
+		if len(listed) > 0 {
+			if !slices.Contains(listedCategoryIDs, category.CategoryID) {
+				continue
+			}
+
+			triggerIDs = slices.DeleteFunc(slices.Clone(triggerIDs), func(id int64) bool {
+				return !slices.Contains(listedTriggerIDs, id)
+			})
+		}
+
+		triggerIDList, d := types.ListValueFrom(ctx, types.Int64Type, triggerIDs)
+
+		diags.Append(d...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		categories = append(categories, TriggerOrderCategoryResourceModel{
+			CategoryID: types.Int64Value(category.CategoryID),
+			TriggerIDs: triggerIDList,
+		})
+	}
+
+	*o = TriggerOrderResourceModel{
+		ID:         types.StringValue(TriggerOrderID),
+		Categories: categories,
+	}
+
+	return diags
+}
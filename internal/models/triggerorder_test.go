@@ -0,0 +1,114 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var testTriggerOrder = []TriggerCategoryOrder{
+	{CategoryID: 2, TriggerIDs: []int64{30, 10}},
+	{CategoryID: 1, TriggerIDs: []int64{20}},
+	{CategoryID: 3, TriggerIDs: []int64{}},
+}
+
+func testTriggerIDList(ids ...int64) types.List {
+	values := make([]attr.Value, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, types.Int64Value(id))
+	}
+
+	return types.ListValueMust(types.Int64Type, values)
+}
+
+func TestNewTriggerOrder(t *testing.T) {
+	categories := []zendesk.TriggerCategory{
+		{ID: "3", Position: 2},
+		{ID: "1", Position: 1},
+		{ID: "2", Position: 0},
+	}
+	triggers := []zendesk.Trigger{
+		{ID: 10, Position: 1, CategoryID: "2"},
+		{ID: 20, Position: 0, CategoryID: "1"},
+		{ID: 30, Position: 0, CategoryID: "2"},
+	}
+
+	out := NewTriggerOrder(categories, triggers)
+
+	if !reflect.DeepEqual(out, testTriggerOrder) {
+		t.Fatalf(errorOutputMismatch, "should order categories and their triggers by position", out, testTriggerOrder)
+	}
+}
+
+func TestTriggerOrderResourceModel_GetApiModelFromTfModel(t *testing.T) {
+	input := TriggerOrderResourceModel{
+		Categories: []TriggerOrderCategoryResourceModel{
+			{CategoryID: types.Int64Value(2), TriggerIDs: testTriggerIDList(30, 10)},
+			{CategoryID: types.Int64Value(1), TriggerIDs: testTriggerIDList(20)},
+			{CategoryID: types.Int64Value(3), TriggerIDs: testTriggerIDList()},
+		},
+	}
+
+	out, diags := input.GetApiModelFromTfModel(t.Context())
+
+	if diags.HasError() {
+		diagnosticErrorHelper(t, diags, "Diagnostic Error found running 'GetApiModelFromTfModel'")
+	}
+
+	if !reflect.DeepEqual(out, testTriggerOrder) {
+		t.Fatalf(errorOutputMismatch, "should keep the configured order", out, testTriggerOrder)
+	}
+}
+
+func TestTriggerOrderResourceModel_GetTfModelFromApiModel(t *testing.T) {
+	cases := []struct {
+		testName string
+		target   TriggerOrderResourceModel
+		expected []TriggerOrderCategoryResourceModel
+	}{
+		{
+			testName: "should list every category and trigger on import",
+			target:   TriggerOrderResourceModel{},
+			expected: []TriggerOrderCategoryResourceModel{
+				{CategoryID: types.Int64Value(2), TriggerIDs: testTriggerIDList(30, 10)},
+				{CategoryID: types.Int64Value(1), TriggerIDs: testTriggerIDList(20)},
+				{CategoryID: types.Int64Value(3), TriggerIDs: testTriggerIDList()},
+			},
+		},
+		{
+			testName: "should only keep the listed categories and triggers in their current order",
+			target: TriggerOrderResourceModel{
+				Categories: []TriggerOrderCategoryResourceModel{
+					{CategoryID: types.Int64Value(1), TriggerIDs: testTriggerIDList(20, 30)},
+					{CategoryID: types.Int64Value(2), TriggerIDs: testTriggerIDList()},
+				},
+			},
+			expected: []TriggerOrderCategoryResourceModel{
+				{CategoryID: types.Int64Value(2), TriggerIDs: testTriggerIDList(30)},
+				{CategoryID: types.Int64Value(1), TriggerIDs: testTriggerIDList(20)},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			expected := TriggerOrderResourceModel{
+				ID:         types.StringValue(TriggerOrderID),
+				Categories: c.expected,
+			}
+
+			diags := c.target.GetTfModelFromApiModel(t.Context(), testTriggerOrder)
+
+			if diags.HasError() {
+				diagnosticErrorHelper(t, diags, "Diagnostic Error found running 'GetTfModelFromApiModel'")
+			}
+
+			if !reflect.DeepEqual(c.target, expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, c.target, expected)
+			}
+		})
+	}
+}
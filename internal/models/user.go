@@ -0,0 +1,114 @@
+package models
+
+import (
+	"context"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// UserDestroyActionSuspend suspends users on destroy, keeping their tickets and history.
+	UserDestroyActionSuspend = "suspend"
+	// UserDestroyActionDelete deletes users on destroy.
+	UserDestroyActionDelete = "delete"
+)
+
+var _ ResourceTransformWithID[zendesk.User] = &UserResourceModel{}
+
+// UserResourceModel is struct for agent and admin user payload
+// https://developer.zendesk.com/api-reference/ticketing/users/users/
+type UserResourceModel struct {
+	ID             types.Int64  `tfsdk:"id"`
+	URL            types.String `tfsdk:"url"`
+	Name           types.String `tfsdk:"name"`
+	Email          types.String `tfsdk:"email"`
+	Role           types.String `tfsdk:"role"`
+	CustomRoleID   types.Int64  `tfsdk:"custom_role_id"`
+	DefaultGroupID types.Int64  `tfsdk:"default_group_id"`
+	OrganizationID types.Int64  `tfsdk:"organization_id"`
+	TimeZone       types.String `tfsdk:"time_zone"`
+	Locale         types.String `tfsdk:"locale"`
+	Tags           types.Set    `tfsdk:"tags"`
+	UserFields     types.Map    `tfsdk:"user_fields"`
+	Suspended      types.Bool   `tfsdk:"suspended"`
+	DestroyAction  types.String `tfsdk:"destroy_action"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+func (u *UserResourceModel) GetID() int64 {
+	return u.ID.ValueInt64()
+}
+
+func (u *UserResourceModel) GetApiModelFromTfModel(ctx context.Context) (user zendesk.User, diags diag.Diagnostics) {
+	var tags []string
+
+	// Known tags are never nil, so an empty set clears the tags of the user
+	if !u.Tags.IsNull() && !u.Tags.IsUnknown() {
+		tags = []string{}
+		diags.Append(u.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+
+	userFields, d := getApiCustomFieldValuesFromTf(ctx, u.UserFields)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return user, diags
+	}
+
+	user = zendesk.User{
+		Name:           u.Name.ValueString(),
+		Email:          u.Email.ValueString(),
+		Role:           u.Role.ValueString(),
+		CustomRoleID:   u.CustomRoleID.ValueInt64(),
+		DefaultGroupID: u.DefaultGroupID.ValueInt64(),
+		OrganizationID: u.OrganizationID.ValueInt64(),
+		Timezone:       u.TimeZone.ValueString(),
+		Locale:         u.Locale.ValueString(),
+		Tags:           tags,
+		UserFields:     userFields,
+	}
+
+	return user, diags
+}
+
+// GetTfModelFromApiModel keeps the destroy action, which is only known to Terraform.
+func (u *UserResourceModel) GetTfModelFromApiModel(ctx context.Context, user zendesk.User) (diags diag.Diagnostics) {
+	tags, d := types.SetValueFrom(ctx, types.StringType, nonNilStrings(user.Tags))
+	diags.Append(d...)
+
+	userFields, d := getTfCustomFieldValuesFromApi(ctx, u.UserFields, user.UserFields)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	destroyAction := u.DestroyAction
+	if destroyAction.IsNull() || destroyAction.IsUnknown() {
+		destroyAction = types.StringValue(UserDestroyActionSuspend)
+	}
+
+	*u = UserResourceModel{
+		ID:             types.Int64Value(user.ID),
+		URL:            types.StringValue(user.URL),
+		Name:           types.StringValue(user.Name),
+		Email:          types.StringValue(user.Email),
+		Role:           types.StringValue(user.Role),
+		CustomRoleID:   int64ValueOrNull(user.CustomRoleID),
+		DefaultGroupID: int64ValueOrNull(user.DefaultGroupID),
+		OrganizationID: int64ValueOrNull(user.OrganizationID),
+		TimeZone:       types.StringValue(user.Timezone),
+		Locale:         types.StringValue(user.Locale),
+		Tags:           tags,
+		UserFields:     userFields,
+		Suspended:      types.BoolValue(user.Suspended),
+		DestroyAction:  destroyAction,
+		CreatedAt:      types.StringValue(user.CreatedAt.UTC().String()),
+		UpdatedAt:      types.StringValue(user.UpdatedAt.UTC().String()),
+	}
+
+	return diags
+}
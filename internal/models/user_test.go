@@ -0,0 +1,161 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUserResourceModel_GetApiModelFromTfModel(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    UserResourceModel
+		expected zendesk.User
+	}{
+		{
+			testName: "should get a api model from a tf resource",
+			input: UserResourceModel{
+				Name:           types.StringValue(testTitle),
+				Email:          types.StringValue("agent@example.org"),
+				Role:           types.StringValue("agent"),
+				CustomRoleID:   types.Int64Value(2),
+				DefaultGroupID: types.Int64Unknown(),
+				OrganizationID: types.Int64Null(),
+				TimeZone:       types.StringValue("Pacific Time (US & Canada)"),
+				Locale:         types.StringUnknown(),
+				Tags:           types.SetValueMust(types.StringType, []attr.Value{types.StringValue("tier_2")}),
+				UserFields: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"team": types.StringValue("billing"),
+				}),
+				DestroyAction: types.StringValue(UserDestroyActionSuspend),
+			},
+			expected: zendesk.User{
+				Name:         testTitle,
+				Email:        "agent@example.org",
+				Role:         "agent",
+				CustomRoleID: 2,
+				Timezone:     "Pacific Time (US & Canada)",
+				Tags:         []string{"tier_2"},
+				UserFields:   zendesk.UserFields{"team": "billing"},
+			},
+		},
+		{
+			testName: "should send empty tags to clear them",
+			input: UserResourceModel{
+				Name:           types.StringValue(testTitle),
+				Email:          types.StringValue("agent@example.org"),
+				Role:           types.StringValue("agent"),
+				CustomRoleID:   types.Int64Unknown(),
+				DefaultGroupID: types.Int64Unknown(),
+				OrganizationID: types.Int64Unknown(),
+				TimeZone:       types.StringUnknown(),
+				Locale:         types.StringUnknown(),
+				Tags:           types.SetValueMust(types.StringType, []attr.Value{}),
+				UserFields:     types.MapUnknown(types.StringType),
+				DestroyAction:  types.StringValue(UserDestroyActionSuspend),
+			},
+			expected: zendesk.User{
+				Name:  testTitle,
+				Email: "agent@example.org",
+				Role:  "agent",
+				Tags:  []string{},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			out, diags := c.input.GetApiModelFromTfModel(t.Context())
+			if diags.HasError() {
+				t.Fatalf("GetApiModelFromTfModel() got error: %v", diags.Errors())
+			}
+			if !reflect.DeepEqual(out, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, out, c.expected)
+			}
+		})
+	}
+}
+
+func TestUserResourceModel_GetTfModelFromApiModel(t *testing.T) {
+	apiUser := zendesk.User{
+		ID:             testId,
+		URL:            testUrl,
+		Name:           testTitle,
+		Email:          "agent@example.org",
+		Active:         true,
+		Role:           "admin",
+		CustomRoleID:   0,
+		DefaultGroupID: 3,
+		Timezone:       "UTC",
+		Locale:         "en-US",
+		UserFields:     zendesk.UserFields{"team": "billing", "on_call": true},
+		CreatedAt:      testCreatedAt,
+		UpdatedAt:      testUpdatedAt,
+	}
+
+	expected := func(destroyAction string, userFields map[string]attr.Value) UserResourceModel {
+		return UserResourceModel{
+			ID:             types.Int64Value(testId),
+			URL:            types.StringValue(testUrl),
+			Name:           types.StringValue(testTitle),
+			Email:          types.StringValue("agent@example.org"),
+			Role:           types.StringValue("admin"),
+			CustomRoleID:   types.Int64Null(),
+			DefaultGroupID: types.Int64Value(3),
+			OrganizationID: types.Int64Null(),
+			TimeZone:       types.StringValue("UTC"),
+			Locale:         types.StringValue("en-US"),
+			Tags:           types.SetValueMust(types.StringType, []attr.Value{}),
+			UserFields:     types.MapValueMust(types.StringType, userFields),
+			Suspended:      types.BoolValue(false),
+			DestroyAction:  types.StringValue(destroyAction),
+			CreatedAt:      types.StringValue(testCreatedAt.UTC().String()),
+			UpdatedAt:      types.StringValue(testUpdatedAt.UTC().String()),
+		}
+	}
+
+	cases := []struct {
+		testName string
+		target   UserResourceModel
+		input    zendesk.User
+		expected UserResourceModel
+	}{
+		{
+			testName: "should default the destroy action on import",
+			target:   UserResourceModel{},
+			input:    apiUser,
+			expected: expected(UserDestroyActionSuspend, map[string]attr.Value{
+				"team":    types.StringValue("billing"),
+				"on_call": types.StringValue("true"),
+			}),
+		},
+		{
+			testName: "should keep the destroy action and prior user fields",
+			target: UserResourceModel{
+				DestroyAction: types.StringValue(UserDestroyActionDelete),
+				UserFields: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"team": types.StringValue("billing"),
+				}),
+			},
+			input: apiUser,
+			expected: expected(UserDestroyActionDelete, map[string]attr.Value{
+				"team": types.StringValue("billing"),
+			}),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			diags := c.target.GetTfModelFromApiModel(t.Context(), c.input)
+			if diags.HasError() {
+				t.Fatalf("GetTfModelFromApiModel() got error: %v", diags.Errors())
+			}
+			if !reflect.DeepEqual(c.target, c.expected) {
+				t.Fatalf(errorOutputMismatch, c.testName, c.target, c.expected)
+			}
+		})
+	}
+}
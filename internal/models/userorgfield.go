@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"reflect"
 )
 
 type UserOrgFieldResourceModel struct {
@@ -78,34 +77,6 @@ func (u *UserFieldResourceModel) GetApiModelFromTfModel(ctx context.Context) (us
 
 }
 
-func getTfRelationshipFiltersObjectsFromApi(ctx context.Context, relationshipFilterObjects []zendesk.RelationshipFilterObject) (filterList types.List, diags diag.Diagnostics) {
-	var filterObjectResourceModels []RelationshipFilterObjectResourceModel
-
-	if len(relationshipFilterObjects) > 0 {
-		for i, object := range relationshipFilterObjects {
-			filterObjectResourceModels[i] = RelationshipFilterObjectResourceModel{
-				Field:    types.StringValue(object.Field),
-				Operator: types.StringValue(object.Operator),
-				Value:    types.StringValue(object.Value),
-			}
-		}
-
-		filterList, diags = types.ListValueFrom(
-			ctx,
-			types.ObjectType{AttrTypes: filterObjectResourceModels[0].AttributeTypes()},
-			filterObjectResourceModels,
-		)
-
-		if diags.HasError() {
-			return filterList, diags
-		}
-
-	} else {
-		filterList = types.ListNull(types.ObjectType{AttrTypes: RelationshipFilterObjectResourceModel{}.AttributeTypes()})
-	}
-	return filterList, diags
-}
-
 func (u *UserFieldResourceModel) GetTfModelFromApiModel(ctx context.Context, userField zendesk.UserField) (diags diag.Diagnostics) {
 
 	var tfCustomOptionsList types.List
@@ -116,42 +87,10 @@ func (u *UserFieldResourceModel) GetTfModelFromApiModel(ctx context.Context, use
 		return diags
 	}
 
-	var tfRelationshipFilterObject types.Object
-
-	if !reflect.DeepEqual(userField.RelationshipFilter, zendesk.RelationshipFilter{}) {
-
-		allObjects := userField.RelationshipFilter.All
-
-		var tfAllRelationshipFilterList types.List
-
-		tfAllRelationshipFilterList, diags = getTfRelationshipFiltersObjectsFromApi(ctx, allObjects)
-
-		if diags.HasError() {
-			return diags
-		}
-
-		anyObjects := userField.RelationshipFilter.Any
-
-		var tfAnyRelationshipFilterList types.List
-
-		tfAnyRelationshipFilterList, diags = getTfRelationshipFiltersObjectsFromApi(ctx, anyObjects)
-
-		if diags.HasError() {
-			return diags
-		}
-
-		tfRelationshipFilter := RelationshipFilterResourceModel{
-			All: tfAllRelationshipFilterList,
-			Any: tfAnyRelationshipFilterList,
-		}
+	tfRelationshipFilterObject, diags := getTfRelationshipFilterFromApi(ctx, userField.RelationshipFilter)
 
-		tfRelationshipFilterObject, diags = types.ObjectValueFrom(ctx, tfRelationshipFilter.AttributeTypes(), tfRelationshipFilter)
-		if diags.HasError() {
-			return diags
-		}
-
-	} else {
-		tfRelationshipFilterObject = types.ObjectNull(RelationshipFilterResourceModel{}.AttributeTypes())
+	if diags.HasError() {
+		return diags
 	}
 
 	var tfDescription types.String
@@ -264,42 +203,10 @@ func (o *OrganizationFieldResourceModel) GetTfModelFromApiModel(ctx context.Cont
 		return diags
 	}
 
-	var tfRelationshipFilterObject types.Object
-
-	if !reflect.DeepEqual(organizationField.RelationshipFilter, zendesk.RelationshipFilter{}) {
-
-		allObjects := organizationField.RelationshipFilter.All
-
-		var tfAllRelationshipFilterList types.List
-
-		tfAllRelationshipFilterList, diags = getTfRelationshipFiltersObjectsFromApi(ctx, allObjects)
-
-		if diags.HasError() {
-			return diags
-		}
+	tfRelationshipFilterObject, diags := getTfRelationshipFilterFromApi(ctx, organizationField.RelationshipFilter)
 
-		anyObjects := organizationField.RelationshipFilter.Any
-
-		var tfAnyRelationshipFilterList types.List
-
-		tfAnyRelationshipFilterList, diags = getTfRelationshipFiltersObjectsFromApi(ctx, anyObjects)
-
-		if diags.HasError() {
-			return diags
-		}
-
-		tfRelationshipFilter := RelationshipFilterResourceModel{
-			All: tfAllRelationshipFilterList,
-			Any: tfAnyRelationshipFilterList,
-		}
-
-		tfRelationshipFilterObject, diags = types.ObjectValueFrom(ctx, tfRelationshipFilter.AttributeTypes(), tfRelationshipFilter)
-		if diags.HasError() {
-			return diags
-		}
-
-	} else {
-		tfRelationshipFilterObject = types.ObjectNull(RelationshipFilterResourceModel{}.AttributeTypes())
+	if diags.HasError() {
+		return diags
 	}
 
 	var tfDescription types.String
@@ -0,0 +1,15 @@
+package models
+
+import "github.com/JacobPotter/go-zendesk/zendesk"
+
+// ViewOrderID is the ID of the view order, there is one per account.
+const ViewOrderID = "view_order"
+
+// ViewIDsByPosition returns the IDs of the views ordered by position, views sharing a
+// position are ordered by ID.
+func ViewIDsByPosition(views []zendesk.View) []int64 {
+	return idsByPosition(views,
+		func(view zendesk.View) int64 { return view.ID },
+		func(view zendesk.View) int64 { return view.Position },
+	)
+}
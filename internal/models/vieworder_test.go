@@ -0,0 +1,22 @@
+package models
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+func TestViewIDsByPosition(t *testing.T) {
+	views := []zendesk.View{
+		{ID: 3, Position: 1},
+		{ID: 2, Position: 0},
+		{ID: 1, Position: 1},
+	}
+
+	out := ViewIDsByPosition(views)
+
+	if !slices.Equal(out, []int64{2, 1, 3}) {
+		t.Fatalf(errorOutputMismatch, "should order the views by position then ID", out, []int64{2, 1, 3})
+	}
+}
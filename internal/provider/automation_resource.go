@@ -76,7 +76,7 @@ func (t *AutomationResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 // ImportState implements resource.ResourceWithImportState.
 func (t *AutomationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	models.ImportResource(ctx, req, resp, &models.AutomationResourceModel{}, t.client.GetAutomation)
+	models.ImportResource(ctx, req, resp, &models.AutomationResourceModel{}, t.client.GetAutomation, automationImportLookup(t.client))
 }
 
 // ValidateConfig Validates config for Automation Resource.
@@ -177,3 +177,15 @@ func (t *AutomationResource) UpgradeState(ctx context.Context) map[int64]resourc
 		},
 	}
 }
+
+func automationImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.Automation] {
+	return models.ImportLookup[zendesk.Automation]{
+		List: func(ctx context.Context) ([]zendesk.Automation, error) {
+			return listAll[zendesk.Automation](ctx, zdClient, "/automations.json", "automations")
+		},
+		ID: func(automation zendesk.Automation) string { return formatID(automation.ID) },
+		Attributes: map[string]func(zendesk.Automation) string{
+			"title": func(automation zendesk.Automation) string { return automation.Title },
+		},
+	}
+}
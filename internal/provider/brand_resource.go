@@ -64,5 +64,18 @@ func (b *BrandResource) Delete(ctx context.Context, request resource.DeleteReque
 }
 
 func (b *BrandResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	models.ImportResource(ctx, request, response, &models.BrandResourceModel{}, b.client.GetBrand)
+	models.ImportResource(ctx, request, response, &models.BrandResourceModel{}, b.client.GetBrand, brandImportLookup(b.client))
+}
+
+func brandImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.Brand] {
+	return models.ImportLookup[zendesk.Brand]{
+		List: func(ctx context.Context) ([]zendesk.Brand, error) {
+			return listAll[zendesk.Brand](ctx, zdClient, "/brands.json", "brands")
+		},
+		ID: func(brand zendesk.Brand) string { return formatID(brand.ID) },
+		Attributes: map[string]func(zendesk.Brand) string{
+			"name":      func(brand zendesk.Brand) string { return brand.Name },
+			"subdomain": func(brand zendesk.Brand) string { return brand.Subdomain },
+		},
+	}
 }
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"github.com/JacobPotter/go-zendesk/zendesk"
+	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -48,7 +50,8 @@ func (c *ConditionsValidator) ValidateObject(ctx context.Context, req validator.
 	value := attributes["value"].(types.String)
 	values := attributes["values"].(types.List)
 
-	if value.IsUnknown() || values.IsUnknown() {
+	// values referencing other resources, ex: custom status IDs, are only known at apply time
+	if value.IsUnknown() || values.IsUnknown() || slices.ContainsFunc(values.Elements(), attr.Value.IsUnknown) {
 		return
 	}
 
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConditionsValidator(t *testing.T) {
+	attributeTypes := map[string]attr.Type{
+		"field":           types.StringType,
+		"operator":        types.StringType,
+		"value":           types.StringType,
+		"values":          types.ListType{ElemType: types.StringType},
+		"custom_field_id": types.Int64Type,
+	}
+
+	cases := []struct {
+		testName    string
+		field       string
+		operator    string
+		values      types.List
+		expectError bool
+	}{
+		{
+			testName: "should accept custom status ids",
+			field:    "custom_status_id",
+			operator: "includes",
+			values:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("123")}),
+		},
+		{
+			testName: "should skip custom status ids known at apply time",
+			field:    "custom_status_id",
+			operator: "includes",
+			values:   types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()}),
+		},
+		{
+			testName:    "should reject invalid custom status ids",
+			field:       "custom_status_id",
+			operator:    "includes",
+			values:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("pending")}),
+			expectError: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			condition := types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+				"field":           types.StringValue(c.field),
+				"operator":        types.StringValue(c.operator),
+				"value":           types.StringNull(),
+				"values":          c.values,
+				"custom_field_id": types.Int64Null(),
+			})
+			request := validator.ObjectRequest{Path: path.Root("conditions"), ConfigValue: condition}
+			response := &validator.ObjectResponse{}
+
+			(&ConditionsValidator{ConfigType: "trigger"}).ValidateObject(t.Context(), request, response)
+
+			if response.Diagnostics.HasError() != c.expectError {
+				t.Fatalf("expected error %t, got diagnostics: %+v", c.expectError, response.Diagnostics)
+			}
+		})
+	}
+}
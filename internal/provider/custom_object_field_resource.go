@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithImportState = &CustomObjectFieldResource{}
+var _ resource.ResourceWithConfigure = &CustomObjectFieldResource{}
+var _ resource.ResourceWithValidateConfig = &CustomObjectFieldResource{}
+
+type CustomObjectFieldResource struct {
+	client *zendeskapi.Client
+}
+
+func NewCustomObjectFieldResource() resource.Resource {
+	return &CustomObjectFieldResource{}
+}
+
+func (f *CustomObjectFieldResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_custom_object_field"
+}
+
+func (f *CustomObjectFieldResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*zendesk.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendesk.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	f.client = zendeskapi.NewClient(client)
+}
+
+func (f *CustomObjectFieldResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = GetCustomObjectFieldSchema()
+}
+
+func (f *CustomObjectFieldResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	data := &models.CustomObjectFieldResourceModel{}
+	models.CreateResource(ctx, request, response, data, func(ctx context.Context, field zendeskapi.CustomObjectField) (zendeskapi.CustomObjectField, error) {
+		return f.client.CreateCustomObjectField(ctx, data.CustomObjectKey.ValueString(), field)
+	})
+}
+
+func (f *CustomObjectFieldResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	data := &models.CustomObjectFieldResourceModel{}
+	models.ReadResource(ctx, request, response, data, func(ctx context.Context, id int64) (zendeskapi.CustomObjectField, error) {
+		return f.client.GetCustomObjectField(ctx, data.CustomObjectKey.ValueString(), id)
+	})
+}
+
+func (f *CustomObjectFieldResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	data := &models.CustomObjectFieldResourceModel{}
+	models.UpdateResource(ctx, request, response, data, func(ctx context.Context, id int64, field zendeskapi.CustomObjectField) (zendeskapi.CustomObjectField, error) {
+		return f.client.UpdateCustomObjectField(ctx, data.CustomObjectKey.ValueString(), id, field)
+	})
+}
+
+func (f *CustomObjectFieldResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	data := &models.CustomObjectFieldResourceModel{}
+	models.DeleteResource[zendeskapi.CustomObjectField](ctx, request, response, data, func(ctx context.Context, id int64) error {
+		return f.client.DeleteCustomObjectField(ctx, data.CustomObjectKey.ValueString(), id)
+	})
+}
+
+// ImportState imports a field with an ID formatted as <custom_object_key>/<field_id>.
+func (f *CustomObjectFieldResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	objectKey, fieldID, err := parseCustomObjectFieldID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("Invalid import id", err.Error())
+		return
+	}
+
+	field, err := f.client.GetCustomObjectField(ctx, objectKey, fieldID)
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error importing resource", err, response.State.Schema)...)
+		return
+	}
+
+	data := models.CustomObjectFieldResourceModel{
+		CustomObjectKey: types.StringValue(objectKey),
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, field)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// ValidateConfig requires the target of lookup fields, Zendesk would only reject it on apply.
+func (f *CustomObjectFieldResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data models.CustomObjectFieldResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.ValueString() == "lookup" && data.RelationshipTargetType.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("relationship_target_type"),
+			"Missing required argument",
+			"relationship_target_type is required for lookup fields, ex: zen:custom_object:asset",
+		)
+	}
+}
+
+// parseCustomObjectFieldID splits a <custom_object_key>/<field_id> import ID.
+func parseCustomObjectFieldID(id string) (objectKey string, fieldID int64, err error) {
+	objectKey, fieldIDStr, found := strings.Cut(id, "/")
+	if found {
+		fieldID, err = strconv.ParseInt(fieldIDStr, 10, 64)
+	}
+	if !found || objectKey == "" || err != nil {
+		return "", 0, fmt.Errorf("import id %q must be formatted as <custom_object_key>/<field_id>, ex: asset/123", id)
+	}
+
+	return objectKey, fieldID, nil
+}
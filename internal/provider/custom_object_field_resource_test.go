@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+var dummyCustomObjectFieldResourceName = "zendesk_custom_object_field.test"
+
+func TestAccCustomObjectField(t *testing.T) {
+	t.Parallel()
+
+	t.Run("lookup_fields", func(t *testing.T) {
+		key := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"key": config.StringVariable(key),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							"zendesk_custom_object_field.contract",
+							tfjsonpath.New("relationship_target_type"),
+							knownvalue.StringExact("zen:custom_object:"+key+"_contract"),
+						),
+						statecheck.ExpectKnownValue(
+							"zendesk_custom_object_field.status",
+							tfjsonpath.New("custom_field_options"),
+							knownvalue.ListSizeExact(2),
+						),
+						statecheck.ExpectKnownValue(
+							"zendesk_user_field.asset",
+							tfjsonpath.New("relationship_target_type"),
+							knownvalue.StringExact("zen:custom_object:"+key+"_asset"),
+						),
+					},
+				},
+				{
+					ResourceName: dummyCustomObjectFieldResourceName,
+					ImportState:  true,
+					ImportStateIdFunc: func(state *terraform.State) (string, error) {
+						field := state.RootModule().Resources[dummyCustomObjectFieldResourceName].Primary
+						return field.Attributes["custom_object_key"] + "/" + field.ID, nil
+					},
+					ImportStateVerify: true,
+					ConfigFile:        config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"key": config.StringVariable(key),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestCustomObjectFieldResource_ValidateConfig(t *testing.T) {
+	cases := []struct {
+		testName    string
+		fieldType   string
+		targetType  tftypes.Value
+		expectError bool
+	}{
+		{testName: "should accept lookup fields with a target", fieldType: "lookup", targetType: tftypes.NewValue(tftypes.String, "zen:custom_object:contract")},
+		{testName: "should accept targets known at apply time", fieldType: "lookup", targetType: tftypes.NewValue(tftypes.String, tftypes.UnknownValue)},
+		{testName: "should accept other fields without a target", fieldType: "text", targetType: tftypes.NewValue(tftypes.String, nil)},
+		{testName: "should reject lookup fields without a target", fieldType: "lookup", targetType: tftypes.NewValue(tftypes.String, nil), expectError: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			fieldSchema := GetCustomObjectFieldSchema()
+			state := testResourceState(t, fieldSchema, map[string]tftypes.Value{
+				"custom_object_key":        tftypes.NewValue(tftypes.String, "asset"),
+				"key":                      tftypes.NewValue(tftypes.String, "contract"),
+				"type":                     tftypes.NewValue(tftypes.String, c.fieldType),
+				"name":                     tftypes.NewValue(tftypes.String, "Contract"),
+				"relationship_target_type": c.targetType,
+			})
+			request := fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}
+			response := &fwresource.ValidateConfigResponse{}
+
+			NewCustomObjectFieldResource().(*CustomObjectFieldResource).ValidateConfig(t.Context(), request, response)
+
+			if response.Diagnostics.HasError() != c.expectError {
+				t.Fatalf("expected error %t, got diagnostics: %+v", c.expectError, response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestParseCustomObjectFieldID(t *testing.T) {
+	cases := []struct {
+		testName    string
+		input       string
+		objectKey   string
+		field       int64
+		expectError bool
+	}{
+		{testName: "should parse custom object key and field id", input: "asset/456", objectKey: "asset", field: 456},
+		{testName: "should reject a field id alone", input: "456", expectError: true},
+		{testName: "should reject a missing custom object key", input: "/456", expectError: true},
+		{testName: "should reject non numeric field ids", input: "asset/serial_number", expectError: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			objectKey, fieldID, err := parseCustomObjectFieldID(c.input)
+
+			if (err != nil) != c.expectError {
+				t.Fatalf("expected error %t, got %v", c.expectError, err)
+			}
+
+			if objectKey != c.objectKey || fieldID != c.field {
+				t.Fatalf("expected %s/%d, got %s/%d", c.objectKey, c.field, objectKey, fieldID)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.ResourceWithImportState = &CustomObjectResource{}
+var _ resource.ResourceWithConfigure = &CustomObjectResource{}
+
+type CustomObjectResource struct {
+	client *zendeskapi.Client
+}
+
+func NewCustomObjectResource() resource.Resource {
+	return &CustomObjectResource{}
+}
+
+func (o *CustomObjectResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*zendesk.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendesk.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	o.client = zendeskapi.NewClient(client)
+}
+
+func (o *CustomObjectResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_custom_object"
+}
+
+func (o *CustomObjectResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = CustomObjectSchema
+}
+
+func (o *CustomObjectResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data models.CustomObjectResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newObject, diags := data.GetApiModelFromTfModel(ctx)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	object, err := o.client.CreateCustomObject(ctx, newObject)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error creating custom object", err, request.Plan.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, object)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (o *CustomObjectResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data models.CustomObjectResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	object, err := o.client.GetCustomObject(ctx, data.ID.ValueString())
+
+	if models.IsNotFound(err) {
+		tflog.Warn(ctx, "Custom object not found, removing from state", map[string]any{"id": data.ID.ValueString()})
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error reading custom object", err, request.State.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, object)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (o *CustomObjectResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data models.CustomObjectResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updatedObject, diags := data.GetApiModelFromTfModel(ctx)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	object, err := o.client.UpdateCustomObject(ctx, data.ID.ValueString(), updatedObject)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error updating custom object", err, request.Plan.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, object)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (o *CustomObjectResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data models.CustomObjectResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	err := o.client.DeleteCustomObject(ctx, data.ID.ValueString())
+
+	if err != nil && !models.IsNotFound(err) {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error deleting custom object", err, request.State.Schema)...)
+		return
+	}
+}
+
+// ImportState imports a custom object by its key, or by title with an ID formatted as title:<title>.
+func (o *CustomObjectResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	var data models.CustomObjectResourceModel
+
+	key := request.ID
+
+	// keys can't contain a colon, any ID with a prefix is resolved by title
+	if strings.Contains(key, ":") {
+		var diags diag.Diagnostics
+		key, diags = customObjectImportLookup(o.client.Client).Resolve(ctx, key)
+
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	object, err := o.client.GetCustomObject(ctx, key)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error importing resource", err, response.State.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, object)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func customObjectImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendeskapi.CustomObject] {
+	return models.ImportLookup[zendeskapi.CustomObject]{
+		List: zendeskapi.NewClient(zdClient).GetCustomObjects,
+		ID:   func(object zendeskapi.CustomObject) string { return object.Key },
+		Attributes: map[string]func(zendeskapi.CustomObject) string{
+			"title": func(object zendeskapi.CustomObject) string { return object.Title },
+		},
+	}
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+var dummyCustomObjectResourceName = "zendesk_custom_object.test"
+
+func TestAccCustomObject(t *testing.T) {
+	t.Parallel()
+
+	t.Run("basic_custom_object", func(t *testing.T) {
+		key := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"key": config.StringVariable(key),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyCustomObjectResourceName,
+							tfjsonpath.New("id"),
+							knownvalue.StringExact(key),
+						),
+						statecheck.ExpectKnownValue(
+							dummyCustomObjectResourceName,
+							tfjsonpath.New("title_pluralized"),
+							knownvalue.StringExact("Assets"),
+						),
+					},
+				},
+				{
+					ResourceName:      dummyCustomObjectResourceName,
+					ImportState:       true,
+					ImportStateId:     key,
+					ImportStateVerify: true,
+					ConfigFile:        config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"key": config.StringVariable(key),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("update_custom_object", func(t *testing.T) {
+		key := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"key": config.StringVariable(key),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyCustomObjectResourceName,
+							tfjsonpath.New("description"),
+							knownvalue.StringExact(""),
+						),
+					},
+				},
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"key": config.StringVariable(key),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyCustomObjectResourceName,
+							tfjsonpath.New("title"),
+							knownvalue.StringExact("Hardware asset"),
+						),
+						statecheck.ExpectKnownValue(
+							dummyCustomObjectResourceName,
+							tfjsonpath.New("description"),
+							knownvalue.StringExact("Hardware lent to employees"),
+						),
+					},
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var CustomObjectSchema = schema.Schema{
+	Version: 0,
+	MarkdownDescription: "Custom object, holding records of data not modeled by Zendesk, ex: assets or contracts. " +
+		"Lookup relationship fields of users, organizations and custom objects can reference it with " +
+		"`zen:custom_object:<key>` as their `relationship_target_type`. " +
+		"Zendesk rejects deleting a custom object while it has records. " +
+		"See [Custom Objects](https://developer.zendesk.com/api-reference/custom-data/custom-objects/custom_objects/) " +
+		"for more information.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Same as the key of the custom object.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"url": schema.StringAttribute{
+			Computed:    true,
+			Description: "The URL for this resource",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"key": schema.StringAttribute{
+			Required: true,
+			Description: "A unique key that identifies the custom object, made of letters, numbers and underscores. " +
+				"Changing the key will delete the custom object and create a new one.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(customObjectKeyRegexp, "must be made of letters, numbers and underscores, and can't be only numbers"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"title": schema.StringAttribute{
+			Required:    true,
+			Description: "Name of the custom object, dynamic content placeholders are accepted",
+		},
+		"title_pluralized": schema.StringAttribute{
+			Required:    true,
+			Description: "Plural name of the custom object, dynamic content placeholders are accepted",
+		},
+		"description": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "User-defined description of the custom object",
+			Default:     stringdefault.StaticString(""),
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The time the custom object was created.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The time of the last update of the custom object.",
+			Computed:    true,
+		},
+	},
+}
+
+// GetCustomObjectFieldSchema extends the user and organization field schema with the key of
+// the custom object, the field key and type can't be changed once the field is created.
+func GetCustomObjectFieldSchema() schema.Schema {
+	fieldSchema := GetUserOrgFieldSchema("custom object")
+
+	fieldSchema.MarkdownDescription = "Field of a custom object. Lookup relationship fields can reference users, " +
+		"organizations, tickets or other custom objects, ex: a contract field on an asset custom object. " +
+		"See [Custom Object Fields](https://developer.zendesk.com/api-reference/custom-data/custom-objects/custom_object_fields/) " +
+		"for more information."
+
+	fieldSchema.Attributes["custom_object_key"] = schema.StringAttribute{
+		Required:    true,
+		Description: "Key of the custom object the field belongs to.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	for _, name := range []string{"key", "type"} {
+		attribute := fieldSchema.Attributes[name].(schema.StringAttribute)
+		attribute.PlanModifiers = append(attribute.PlanModifiers, stringplanmodifier.RequiresReplace())
+		fieldSchema.Attributes[name] = attribute
+	}
+
+	return fieldSchema
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestCustomObjectFieldSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	schemaRequest := resource.SchemaRequest{}
+	schemaResponse := &resource.SchemaResponse{}
+
+	NewCustomObjectFieldResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+
+	// the user field schema is shared, it must not be changed by the custom object field schema
+	if _, ok := GetUserOrgFieldSchema("user").Attributes["custom_object_key"]; ok {
+		t.Fatalf("expected user field schema without custom_object_key")
+	}
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.ResourceWithImportState = &CustomRoleResource{}
+var _ resource.ResourceWithConfigure = &CustomRoleResource{}
+
+type CustomRoleResource struct {
+	client *zendesk.Client
+}
+
+func NewCustomRoleResource() resource.Resource {
+	return &CustomRoleResource{}
+}
+
+func (r *CustomRoleResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*zendesk.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendesk.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomRoleResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_custom_role"
+}
+
+func (r *CustomRoleResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = CustomRoleSchema
+}
+
+func (r *CustomRoleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	models.CreateResource(ctx, request, response, &models.CustomRoleResourceModel{}, r.client.CreateCustomRole)
+}
+
+func (r *CustomRoleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	models.ReadResource(ctx, request, response, &models.CustomRoleResourceModel{}, r.client.GetCustomRole)
+}
+
+func (r *CustomRoleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	models.UpdateResource(ctx, request, response, &models.CustomRoleResourceModel{}, r.client.UpdateCustomRole)
+}
+
+func (r *CustomRoleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	models.DeleteResource[zendesk.CustomRole](ctx, request, response, &models.CustomRoleResourceModel{}, r.client.DeleteCustomRole)
+}
+
+func (r *CustomRoleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	models.ImportResource(ctx, request, response, &models.CustomRoleResourceModel{}, r.client.GetCustomRole, customRoleImportLookup(r.client))
+}
+
+func customRoleImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.CustomRole] {
+	return models.ImportLookup[zendesk.CustomRole]{
+		List: zdClient.GetCustomRoles,
+		ID:   func(role zendesk.CustomRole) string { return formatID(role.ID) },
+		Attributes: map[string]func(zendesk.CustomRole) string{
+			"name": func(role zendesk.CustomRole) string { return role.Name },
+		},
+	}
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+var dummyCustomRoleResourceName = "zendesk_custom_role.test"
+
+func TestAccCustomRole(t *testing.T) {
+	t.Parallel()
+
+	t.Run("basic_custom_role", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyCustomRoleResourceName,
+							tfjsonpath.New("configuration").AtMapKey("ticket_access"),
+							knownvalue.StringExact("within-groups"),
+						),
+						statecheck.ExpectKnownValue(
+							dummyCustomRoleResourceName,
+							tfjsonpath.New("configuration").AtMapKey("ticket_deletion"),
+							knownvalue.Bool(false),
+						),
+					},
+				},
+				{
+					ResourceName:      dummyCustomRoleResourceName,
+					ImportState:       true,
+					ImportStateId:     "name:" + fullResourceName,
+					ImportStateVerify: true,
+					ConfigFile:        config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("update_custom_role", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyCustomRoleResourceName,
+							tfjsonpath.New("configuration").AtMapKey("ticket_access"),
+							knownvalue.StringExact("assigned-only"),
+						),
+					},
+				},
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyCustomRoleResourceName,
+							tfjsonpath.New("name"),
+							knownvalue.StringExact(fullResourceName+" updated"),
+						),
+						statecheck.ExpectKnownValue(
+							dummyCustomRoleResourceName,
+							tfjsonpath.New("configuration").AtMapKey("ticket_merge"),
+							knownvalue.Bool(true),
+						),
+					},
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// customRolePermission is a permission granted when true, permissions default to false.
+func customRolePermission(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: description + ". Defaults to false",
+		Default:     booldefault.StaticBool(false),
+	}
+}
+
+// customRoleAccess is a permission level among values, levels default to the most restrictive
+// one.
+func customRoleAccess(description string, defaultValue string, values ...string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: fmt.Sprintf("%s. Allowed values are %s. Defaults to %s", description, strings.Join(values, ", "), defaultValue),
+		Default:     stringdefault.StaticString(defaultValue),
+		Validators: []validator.String{
+			stringvalidator.OneOf(values...),
+		},
+	}
+}
+
+var CustomRoleSchema = schema.Schema{
+	Version: 0,
+	MarkdownDescription: "Custom agent role, defining what its agents can access and manage. " +
+		"Permissions not set default to their most restrictive value. " +
+		"See [Custom Agent Roles](https://developer.zendesk.com/api-reference/ticketing/account-configuration/custom_roles/) " +
+		"for more information.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "Name of the role",
+		},
+		"description": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Description of the role",
+		},
+		"role_type": schema.Int64Attribute{
+			Optional: true,
+			Computed: true,
+			Description: "Type of the role, 0 for a custom agent role, 1 for a light agent role, 2 for a chat agent role, " +
+				"3 for a contributor role, 4 for an admin role and 5 for a billing admin role. Defaults to 0",
+			Default: int64default.StaticInt64(0),
+			Validators: []validator.Int64{
+				int64validator.Between(0, 5),
+			},
+		},
+		"configuration": schema.SingleNestedAttribute{
+			Required:    true,
+			Description: "Permissions of the role",
+			Attributes: map[string]schema.Attribute{
+				"assign_tickets_to_any_brand":     customRolePermission("Whether the agent can assign tickets to any brand"),
+				"assign_tickets_to_any_group":     customRolePermission("Whether the agent can assign tickets to any group"),
+				"chat_access":                     customRolePermission("Whether the agent has access to Chat"),
+				"end_user_list_access":            customRoleAccess("Whether the agent can list end users", "none", "full", "none"),
+				"end_user_profile_access":         customRoleAccess("What the agent can do with end user profiles", "readonly", "edit", "edit-within-org", "full", "readonly"),
+				"explore_access":                  customRoleAccess("What the agent can do in Explore", "none", "edit", "full", "none", "readonly"),
+				"forum_access":                    customRoleAccess("What the agent can do in the Help Center", "readonly", "edit-topics", "full", "readonly"),
+				"forum_access_restricted_content": customRolePermission("Whether the agent can access restricted Help Center content"),
+				"light_agent":                     customRolePermission("Whether the role is a light agent role, light agents can only add private comments"),
+				"macro_access":                    customRoleAccess("What the agent can do with macros", "readonly", "full", "manage-group", "manage-personal", "readonly"),
+				"manage_automations":              customRolePermission("Whether the agent can create and manage automations"),
+				"manage_business_rules":           customRolePermission("Whether the agent can manage business rules, ex: schedules and routing"),
+				"manage_contextual_workspaces":    customRolePermission("Whether the agent can manage contextual workspaces"),
+				"manage_dynamic_content":          customRolePermission("Whether the agent can manage dynamic content"),
+				"manage_extensions_and_channels":  customRolePermission("Whether the agent can manage channels and extensions"),
+				"manage_facebook":                 customRolePermission("Whether the agent can manage Facebook pages"),
+				"manage_group_memberships":        customRolePermission("Whether the agent can manage group memberships"),
+				"manage_groups":                   customRolePermission("Whether the agent can create and manage groups"),
+				"manage_organization_fields":      customRolePermission("Whether the agent can create and manage organization fields"),
+				"manage_organizations":            customRolePermission("Whether the agent can create and manage organizations"),
+				"manage_roles":                    customRoleAccess("Which roles the agent can manage", "none", "all-except-self", "none"),
+				"manage_skills":                   customRolePermission("Whether the agent can create and manage skills"),
+				"manage_slas":                     customRolePermission("Whether the agent can create and manage SLA policies"),
+				"manage_suspended_tickets":        customRolePermission("Whether the agent can manage suspended tickets"),
+				"manage_team_members":             customRoleAccess("What the agent can do with team members", "none", "all", "none", "readonly"),
+				"manage_ticket_fields":            customRolePermission("Whether the agent can create and manage ticket fields"),
+				"manage_ticket_forms":             customRolePermission("Whether the agent can create and manage ticket forms"),
+				"manage_triggers":                 customRolePermission("Whether the agent can create and manage triggers"),
+				"manage_user_fields":              customRolePermission("Whether the agent can create and manage user fields"),
+				"organization_editing":            customRolePermission("Whether the agent can add or modify organizations"),
+				"organization_notes_editing":      customRolePermission("Whether the agent can add or modify organization notes"),
+				"report_access":                   customRoleAccess("What the agent can do with reports", "none", "full", "none", "readonly"),
+				"side_conversation_create":        customRolePermission("Whether the agent can start side conversations"),
+				"ticket_access":                   customRoleAccess("Which tickets the agent can access", "assigned-only", "all", "assigned-only", "within-groups", "within-groups-and-public-groups", "within-organization"),
+				"ticket_comment_access":           customRoleAccess("Which comments the agent can add, none only allows private comments", "none", "none", "public"),
+				"ticket_deletion":                 customRolePermission("Whether the agent can delete tickets"),
+				"ticket_editing":                  customRolePermission("Whether the agent can edit ticket properties"),
+				"ticket_merge":                    customRolePermission("Whether the agent can merge tickets"),
+				"ticket_redaction":                customRolePermission("Whether the agent can redact ticket content"),
+				"ticket_tag_editing":              customRolePermission("Whether the agent can edit ticket tags"),
+				"twitter_search_access":           customRolePermission("Whether the agent has access to Twitter searches"),
+				"view_access":                     customRoleAccess("What the agent can do with views", "playonly", "full", "manage-group", "manage-personal", "playonly", "readonly"),
+				"view_deleted_tickets":            customRolePermission("Whether the agent can view deleted tickets"),
+				"voice_access":                    customRolePermission("Whether the agent can answer and place calls"),
+				"voice_dashboard_access":          customRolePermission("Whether the agent can view the Talk dashboard"),
+			},
+		},
+		"team_member_count": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Number of agents with the role",
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The time the role was created.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The time of the last update of the role.",
+			Computed:    true,
+		},
+	},
+}
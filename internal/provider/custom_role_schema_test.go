@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestCustomRoleSchema(t *testing.T) {
+	t.Parallel()
+
+	schemaRequest := resource.SchemaRequest{}
+	schemaResponse := &resource.SchemaResponse{}
+
+	NewCustomRoleResource().Schema(t.Context(), schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(t.Context())
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+
+	// Every permission of the schema has to be mapped by the model
+	configuration := schemaResponse.Schema.Attributes["configuration"].(schema.SingleNestedAttribute)
+	attributeTypes := models.CustomRoleConfigurationModel{}.AttributeTypes()
+
+	if len(configuration.Attributes) != len(attributeTypes) {
+		t.Fatalf("expected %d permissions, got %d", len(attributeTypes), len(configuration.Attributes))
+	}
+
+	for name := range configuration.Attributes {
+		if _, ok := attributeTypes[name]; !ok {
+			t.Errorf("permission %s is not mapped by the model", name)
+		}
+	}
+}
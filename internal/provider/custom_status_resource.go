@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.ResourceWithImportState = &CustomStatusResource{}
+var _ resource.ResourceWithConfigure = &CustomStatusResource{}
+
+type CustomStatusResource struct {
+	client *zendeskapi.Client
+}
+
+func NewCustomStatusResource() resource.Resource {
+	return &CustomStatusResource{}
+}
+
+func (s *CustomStatusResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*zendesk.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendesk.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = zendeskapi.NewClient(client)
+}
+
+func (s *CustomStatusResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_custom_status"
+}
+
+func (s *CustomStatusResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = CustomStatusSchema
+}
+
+func (s *CustomStatusResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	models.CreateResource(ctx, request, response, &models.CustomStatusResourceModel{}, s.client.CreateCustomStatus)
+}
+
+func (s *CustomStatusResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	models.ReadResource(ctx, request, response, &models.CustomStatusResourceModel{}, s.client.GetCustomStatus)
+}
+
+func (s *CustomStatusResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	models.UpdateResource(ctx, request, response, &models.CustomStatusResourceModel{}, s.client.UpdateCustomStatus)
+}
+
+// Delete deactivates the status, Zendesk doesn't delete custom statuses.
+func (s *CustomStatusResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	data := &models.CustomStatusResourceModel{}
+	models.DeleteResource[zendeskapi.CustomStatus](ctx, request, response, data, func(ctx context.Context, id int64) error {
+		status, diags := data.GetApiModelFromTfModel(ctx)
+		if diags.HasError() {
+			return errors.New("invalid custom status in state")
+		}
+
+		status.Active = false
+
+		_, err := s.client.UpdateCustomStatus(ctx, id, status)
+
+		return err
+	})
+}
+
+func (s *CustomStatusResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	models.ImportResource(ctx, request, response, &models.CustomStatusResourceModel{}, s.client.GetCustomStatus, customStatusImportLookup(s.client.Client))
+}
+
+func customStatusImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendeskapi.CustomStatus] {
+	return models.ImportLookup[zendeskapi.CustomStatus]{
+		List: zendeskapi.NewClient(zdClient).GetCustomStatuses,
+		ID:   func(status zendeskapi.CustomStatus) string { return formatID(status.ID) },
+		Attributes: map[string]func(zendeskapi.CustomStatus) string{
+			"agent_label": func(status zendeskapi.CustomStatus) string { return status.AgentLabel },
+		},
+	}
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+)
+
+var dummyCustomStatusResourceName = "zendesk_custom_status.test"
+
+func TestAccCustomStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("basic_custom_status", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyCustomStatusResourceName,
+							tfjsonpath.New("status_category"),
+							knownvalue.StringExact("pending"),
+						),
+						statecheck.ExpectKnownValue(
+							dummyCustomStatusResourceName,
+							tfjsonpath.New("active"),
+							knownvalue.Bool(true),
+						),
+					},
+				},
+				{
+					ResourceName:      dummyCustomStatusResourceName,
+					ImportState:       true,
+					ImportStateId:     "agent_label:" + fullResourceName,
+					ImportStateVerify: true,
+					ConfigFile:        config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("update_custom_status", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyCustomStatusResourceName,
+							tfjsonpath.New("end_user_label"),
+							knownvalue.StringExact(fullResourceName),
+						),
+					},
+				},
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyCustomStatusResourceName,
+							tfjsonpath.New("agent_label"),
+							knownvalue.StringExact(fullResourceName+" updated"),
+						),
+						statecheck.ExpectKnownValue(
+							dummyCustomStatusResourceName,
+							tfjsonpath.New("active"),
+							knownvalue.Bool(false),
+						),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("referenced_by_trigger_and_view", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyTriggerResourceName,
+							tfjsonpath.New("conditions").AtMapKey("all").AtSliceIndex(0).AtMapKey("field"),
+							knownvalue.StringExact("custom_status_id"),
+						),
+						statecheck.ExpectKnownValue(
+							dummyViewResourceName,
+							tfjsonpath.New("conditions").AtMapKey("all").AtSliceIndex(0).AtMapKey("field"),
+							knownvalue.StringExact("custom_status_id"),
+						),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestCustomStatusResource_Delete(t *testing.T) {
+	var method, path, body string
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqBody, _ := io.ReadAll(r.Body)
+		method, path, body = r.Method, r.URL.Path, string(reqBody)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"custom_status":{"id":123,"active":false}}`))
+	})
+	r := &CustomStatusResource{client: zendeskapi.NewClient(testZendeskClient(t, handler))}
+
+	state := testResourceState(t, CustomStatusSchema, map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.Number, 123),
+		"status_category": tftypes.NewValue(tftypes.String, "pending"),
+		"agent_label":     tftypes.NewValue(tftypes.String, "Waiting on vendor"),
+		"active":          tftypes.NewValue(tftypes.Bool, true),
+	})
+	response := &fwresource.DeleteResponse{State: state}
+
+	r.Delete(t.Context(), fwresource.DeleteRequest{State: state}, response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", response.Diagnostics)
+	}
+
+	expectedBody := `{"custom_status":{"agent_label":"Waiting on vendor","description":"","end_user_description":"","active":false}}`
+	if method != http.MethodPut || path != "/api/v2/custom_statuses/123.json" || body != expectedBody {
+		t.Fatalf("expected status to be deactivated, got %s %s %s", method, path, body)
+	}
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var CustomStatusSchema = schema.Schema{
+	Version: 0,
+	MarkdownDescription: "Custom ticket status, refining one of the ticket status categories. " +
+		"Its ID can be used as the value of `custom_status_id` conditions of triggers and views. " +
+		"Zendesk doesn't delete custom statuses, destroying the resource deactivates the status. " +
+		"See [Custom Ticket Statuses](https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/) " +
+		"for more information.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"status_category": schema.StringAttribute{
+			Required: true,
+			Description: "Category of the status, allowed values are new, open, pending, hold and solved. " +
+				"Changing the category will deactivate the status and create a new one.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("new", "open", "pending", "hold", "solved"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"agent_label": schema.StringAttribute{
+			Required:    true,
+			Description: "Label of the status shown to agents, dynamic content placeholders are accepted",
+		},
+		"end_user_label": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Label of the status shown to end users, defaults to the agent label",
+		},
+		"description": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Description of the status shown to agents",
+			Default:     stringdefault.StaticString(""),
+		},
+		"end_user_description": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Description of the status shown to end users",
+			Default:     stringdefault.StaticString(""),
+		},
+		"active": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "If true, the status can be set on tickets. Defaults to true",
+			Default:     booldefault.StaticBool(true),
+		},
+		"default": schema.BoolAttribute{
+			Computed:    true,
+			Description: "If true, the status is the default status of its category",
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The time the status was created.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The time of the last update of the status.",
+			Computed:    true,
+		},
+	},
+}
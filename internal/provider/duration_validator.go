@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = DurationValidator{}
+
+// DurationValidator checks a string is a duration time.ParseDuration accepts, ex: 10m, 1h30m
+// or 1.5h. Negative durations are rejected.
+type DurationValidator struct{}
+
+func (d DurationValidator) Description(_ context.Context) string {
+	return "Value must be a duration, ex: 10m or 1h30m"
+}
+
+func (d DurationValidator) MarkdownDescription(ctx context.Context) string {
+	return d.Description(ctx)
+}
+
+func (d DurationValidator) ValidateString(_ context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(request.ConfigValue.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid duration", fmt.Sprintf("%s, ex: 10m or 1h30m", err))
+		return
+	}
+
+	if duration < 0 {
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid duration", fmt.Sprintf("%q must not be negative", request.ConfigValue.ValueString()))
+	}
+}
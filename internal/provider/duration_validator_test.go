@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDurationValidator(t *testing.T) {
+	cases := []struct {
+		testName    string
+		value       string
+		expectError bool
+	}{
+		{testName: "should accept minutes", value: "10m"},
+		{testName: "should accept several units", value: "90s500ms"},
+		{testName: "should accept fractions", value: "1.5h"},
+		{testName: "should reject a missing unit", value: "10", expectError: true},
+		{testName: "should reject negative durations", value: "-1m", expectError: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			request := validator.StringRequest{
+				Path:        path.Root("verification_timeout"),
+				ConfigValue: types.StringValue(c.value),
+			}
+			response := &validator.StringResponse{}
+
+			DurationValidator{}.ValidateString(t.Context(), request, response)
+
+			if response.Diagnostics.HasError() != c.expectError {
+				t.Fatalf("expected error %t, got diagnostics: %+v", c.expectError, response.Diagnostics)
+			}
+		})
+	}
+}
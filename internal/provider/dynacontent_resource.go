@@ -73,14 +73,14 @@ func (d *DynamicContentResource) Update(ctx context.Context, request resource.Up
 	dciResp, err := d.client.UpdateDynamicContentItem(ctx, data.ID.ValueInt64(), updatedDci)
 
 	if err != nil {
-		response.Diagnostics.AddError("Error updating dynamic content item", "Error updating dynamic content item: "+err.Error())
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error updating dynamic content item", err, request.Plan.Schema)...)
 		return
 	}
 
 	_, err = d.client.UpdateDynamicContentVariants(ctx, data.ID.ValueInt64(), updatedDci.Variants)
 
 	if err != nil {
-		response.Diagnostics.AddError("Error updating dynamic content variants", "Error updating dynamic content variants: "+err.Error())
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error updating dynamic content variants", err, request.Plan.Schema)...)
 		return
 	}
 
@@ -103,5 +103,17 @@ func (d *DynamicContentResource) Delete(ctx context.Context, request resource.De
 }
 
 func (d *DynamicContentResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	models.ImportResource(ctx, request, response, &models.DynamicContentItemResourceModel{}, d.client.GetDynamicContentItem)
+	models.ImportResource(ctx, request, response, &models.DynamicContentItemResourceModel{}, d.client.GetDynamicContentItem, dynamicContentImportLookup(d.client))
+}
+
+func dynamicContentImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.DynamicContentItem] {
+	return models.ImportLookup[zendesk.DynamicContentItem]{
+		List: func(ctx context.Context) ([]zendesk.DynamicContentItem, error) {
+			return listAll[zendesk.DynamicContentItem](ctx, zdClient, "/dynamic_content/items.json", "items")
+		},
+		ID: func(item zendesk.DynamicContentItem) string { return formatID(item.ID) },
+		Attributes: map[string]func(zendesk.DynamicContentItem) string{
+			"name": func(item zendesk.DynamicContentItem) string { return item.Name },
+		},
+	}
 }
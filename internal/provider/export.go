@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// exportType describes how objects of a resource type are listed and written as HCL.
+type exportType struct {
+	// name is the resource type name without the provider prefix, ex: trigger
+	name   string
+	schema schema.Schema
+	// references maps top level attributes holding IDs to the type they refer to, ex:
+	// category_id to trigger_category
+	references map[string]string
+	list       func(ctx context.Context, zdClient *zendesk.Client) ([]exportObject, error)
+}
+
+// exportObject is a single listed object, converted to its Terraform state value.
+type exportObject struct {
+	id    string
+	label string
+	value tftypes.Value
+}
+
+// newExportType builds an exportType from a resource import lookup, converting every listed
+// object with the resource model GetTfModelFromApiModel transform.
+func newExportType[M any, T models.ResourceTransform[M]](
+	name string,
+	resourceSchema schema.Schema,
+	lookup func(*zendesk.Client) models.ImportLookup[M],
+	labelAttribute string,
+	newModel func() T,
+	include func(M) bool,
+	references map[string]string,
+) exportType {
+	return exportType{
+		name:       name,
+		schema:     resourceSchema,
+		references: references,
+		list: func(ctx context.Context, zdClient *zendesk.Client) ([]exportObject, error) {
+			l := lookup(zdClient)
+
+			items, err := l.List(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			var objects []exportObject
+
+			for _, item := range items {
+				if include != nil && !include(item) {
+					continue
+				}
+
+				model := newModel()
+				state := tfsdk.State{
+					Schema: resourceSchema,
+					Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil),
+				}
+
+				diags := model.GetTfModelFromApiModel(ctx, item)
+				diags.Append(state.Set(ctx, model)...)
+
+				if diags.HasError() {
+					return nil, fmt.Errorf("converting %s %s: %w", name, l.ID(item), diagnosticsError(diags))
+				}
+
+				objects = append(objects, exportObject{
+					id:    l.ID(item),
+					label: l.Attributes[labelAttribute](item),
+					value: state.Raw,
+				})
+			}
+
+			return objects, nil
+		},
+	}
+}
+
+// exportTypes lists every exportable resource type, referenced types come before the types
+// referring to them.
+var exportTypes = []exportType{
+	newExportType("brand", BrandSchema, brandImportLookup, "name",
+		func() *models.BrandResourceModel { return &models.BrandResourceModel{} }, nil, nil),
+	newExportType("support_address", SupportAddressSchema, supportAddressImportLookup, "email",
+		func() *models.SupportAddressResourceModel { return &models.SupportAddressResourceModel{} }, nil,
+		map[string]string{"brand_id": "brand"}),
+	newExportType("group", GroupSchema, groupImportLookup, "name",
+		func() *models.GroupResourceModel { return &models.GroupResourceModel{} }, nil, nil),
+	newExportType("schedule", ScheduleSchema, scheduleImportLookup, "name",
+		func() *models.ScheduleResourceModel { return &models.ScheduleResourceModel{} }, nil, nil),
+	newExportType("dynamic_content", DynamicContentSchema, dynamicContentImportLookup, "name",
+		func() *models.DynamicContentItemResourceModel { return &models.DynamicContentItemResourceModel{} }, nil, nil),
+	newExportType("ticket_field", TicketFieldSchema, ticketFieldImportLookup, "title",
+		func() *models.TicketFieldResourceModel { return &models.TicketFieldResourceModel{} },
+		// system fields such as subject or status can't be managed
+		func(field zendesk.TicketField) bool { return field.Removable }, nil),
+	newExportType("ticket_form", TicketFormSchema, ticketFormImportLookup, "name",
+		func() *models.TicketFormResourceModel { return &models.TicketFormResourceModel{} }, nil,
+		map[string]string{"ticket_field_ids": "ticket_field", "restricted_brand_ids": "brand"}),
+	newExportType("user_field", GetUserOrgFieldSchema("user"), userFieldImportLookup, "key",
+		func() *models.UserFieldResourceModel { return &models.UserFieldResourceModel{} }, nil, nil),
+	newExportType("organization_field", GetUserOrgFieldSchema("org"), organizationFieldImportLookup, "key",
+		func() *models.OrganizationFieldResourceModel { return &models.OrganizationFieldResourceModel{} }, nil, nil),
+	newExportType("custom_object", CustomObjectSchema, customObjectImportLookup, "key",
+		func() *models.CustomObjectResourceModel { return &models.CustomObjectResourceModel{} }, nil, nil),
+	newExportType("organization", OrganizationSchema, organizationImportLookup, "name",
+		func() *models.OrganizationResourceModel { return &models.OrganizationResourceModel{} }, nil,
+		map[string]string{"group_id": "group"}),
+	newExportType("custom_role", CustomRoleSchema, customRoleImportLookup, "name",
+		func() *models.CustomRoleResourceModel { return &models.CustomRoleResourceModel{} },
+		// the built-in light agent and contributor roles can't be managed
+		func(role zendesk.CustomRole) bool { return role.RoleType == 0 }, nil),
+	newExportType("user", UserSchema, userImportLookup, "email",
+		func() *models.UserResourceModel { return &models.UserResourceModel{} }, nil,
+		map[string]string{"custom_role_id": "custom_role", "default_group_id": "group", "organization_id": "organization"}),
+	newExportType("webhook", WebhookSchema, webhookImportLookup, "name",
+		func() *models.WebhookResourceModel { return &models.WebhookResourceModel{} }, nil, nil),
+	newExportType("custom_status", CustomStatusSchema, customStatusImportLookup, "agent_label",
+		func() *models.CustomStatusResourceModel { return &models.CustomStatusResourceModel{} },
+		// the default status of each category is built-in
+		func(status zendeskapi.CustomStatus) bool { return !status.Default }, nil),
+	newExportType("trigger_category", TriggerCategorySchema, triggerCategoryImportLookup, "name",
+		func() *models.TriggerCategoryResourceModel { return &models.TriggerCategoryResourceModel{} }, nil, nil),
+	newExportType("trigger", TriggerSchema, triggerImportLookup, "title",
+		func() *models.TriggerResourceModel { return &models.TriggerResourceModel{} }, nil,
+		map[string]string{"category_id": "trigger_category"}),
+	newExportType("automation", AutomationSchema, automationImportLookup, "title",
+		func() *models.AutomationResourceModel { return &models.AutomationResourceModel{} }, nil, nil),
+	newExportType("macro", MacroSchema, macroImportLookup, "title",
+		func() *models.MacroResourceModel { return &models.MacroResourceModel{} }, nil, nil),
+	newExportType("view", ViewSchema, viewImportLookup, "title",
+		func() *models.ViewResourceModel { return &models.ViewResourceModel{} }, nil, nil),
+	newExportType("routing_attribute", RoutingAttributeSchema, routingAttributeImportLookup, "name",
+		func() *models.RoutingAttributeResourceModel { return &models.RoutingAttributeResourceModel{} }, nil, nil),
+	newExportType("routing_queue", RoutingQueueSchema, routingQueueImportLookup, "name",
+		func() *models.RoutingQueueResourceModel { return &models.RoutingQueueResourceModel{} }, nil,
+		map[string]string{"primary_groups": "group", "secondary_groups": "group"}),
+	newExportType("sla_policy", SLASchema, slaPolicyImportLookup, "title",
+		func() *models.SLAPolicyResourceModel { return &models.SLAPolicyResourceModel{} }, nil, nil),
+}
+
+// Export lists the objects of the given resource types, ex: trigger, and writes them to one
+// zendesk_<type>.tf file per type in dir, along with import blocks. Every type is exported
+// when typeNames is empty.
+func Export(ctx context.Context, zdClient *zendesk.Client, typeNames []string, dir string) error {
+	selected, err := selectExportTypes(typeNames)
+	if err != nil {
+		return err
+	}
+
+	exported := make(map[string][]exportObject, len(selected))
+
+	for _, t := range selected {
+		objects, err := t.list(ctx, zdClient)
+		if err != nil {
+			return fmt.Errorf("listing %s: %w", t.name, err)
+		}
+		exported[t.name] = objects
+	}
+
+	w := newHCLWriter(exported)
+
+	for _, t := range selected {
+		src, err := w.write(t, exported[t.name])
+		if err != nil {
+			return err
+		}
+
+		fileName := filepath.Join(dir, "zendesk_"+t.name+".tf")
+		if err := os.WriteFile(fileName, src, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RunExport runs the export command with its command line arguments, ex:
+// export -types trigger,macro -out ./zendesk. The client is configured like the provider,
+// from the ZENDESK_* environment variables.
+func RunExport(ctx context.Context, args []string, output io.Writer) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(output)
+
+	types := flags.String("types", "", "comma separated resource types to export, ex: trigger,macro,view. Defaults to all of "+strings.Join(exportTypeNames(), ", "))
+	dir := flags.String("out", ".", "directory the generated .tf files are written to")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var typeNames []string
+	if *types != "" {
+		typeNames = strings.Split(*types, ",")
+	}
+
+	zdClient, diags := newClientFromEnvironment(ctx)
+	if diags.HasError() {
+		return diagnosticsError(diags)
+	}
+
+	if err := os.MkdirAll(*dir, 0o755); err != nil {
+		return err
+	}
+
+	return Export(ctx, zdClient, typeNames, *dir)
+}
+
+// selectExportTypes returns the export types matching typeNames, keeping the exportTypes order.
+func selectExportTypes(typeNames []string) ([]exportType, error) {
+	if len(typeNames) == 0 {
+		return exportTypes, nil
+	}
+
+	for i, name := range typeNames {
+		typeNames[i] = strings.TrimPrefix(strings.TrimSpace(name), "zendesk_")
+
+		if !slices.Contains(exportTypeNames(), typeNames[i]) {
+			return nil, fmt.Errorf("unknown resource type %q, expected one of %s", name, strings.Join(exportTypeNames(), ", "))
+		}
+	}
+
+	var selected []exportType
+	for _, t := range exportTypes {
+		if slices.Contains(typeNames, t.name) {
+			selected = append(selected, t)
+		}
+	}
+
+	return selected, nil
+}
+
+func exportTypeNames() []string {
+	names := make([]string, len(exportTypes))
+	for i, t := range exportTypes {
+		names[i] = t.name
+	}
+	return names
+}
+
+// newClientFromEnvironment configures a client the same way the provider does with an empty
+// provider block, so only environment variables are used.
+func newClientFromEnvironment(ctx context.Context) (*zendesk.Client, diag.Diagnostics) {
+	p := &ZendeskProvider{}
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(configType.AttributeTypes))
+
+	for name, attrType := range configType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	req := provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(configType, values)},
+	}
+
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, req, &resp)
+
+	if resp.Diagnostics.HasError() {
+		return nil, resp.Diagnostics
+	}
+
+	return resp.ResourceData.(*zendesk.Client), resp.Diagnostics
+}
+
+// diagnosticsError joins error diagnostics into a single error.
+func diagnosticsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", d.Summary(), d.Detail()))
+	}
+	return errors.Join(errs...)
+}
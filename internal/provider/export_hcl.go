@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var nonIdentifierChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// hclWriter renders exported objects as resource and import blocks, replacing IDs of other
+// exported objects with references to their resource.
+type hclWriter struct {
+	// names maps type names and IDs to the unique resource name of every exported object
+	names map[string]map[string]string
+}
+
+func newHCLWriter(exported map[string][]exportObject) *hclWriter {
+	w := &hclWriter{names: make(map[string]map[string]string, len(exported))}
+
+	for typeName, objects := range exported {
+		w.names[typeName] = make(map[string]string, len(objects))
+		used := make(map[string]bool, len(objects))
+
+		for _, object := range objects {
+			name := resourceName(typeName, object)
+			for i := 2; used[name]; i++ {
+				name = fmt.Sprintf("%s_%d", resourceName(typeName, object), i)
+			}
+			used[name] = true
+			w.names[typeName][object.id] = name
+		}
+	}
+
+	return w
+}
+
+// resourceName turns an object label into a Terraform resource name, ex: "Escalate VIP"
+// becomes escalate_vip.
+func resourceName(typeName string, object exportObject) string {
+	name := strings.Trim(nonIdentifierChars.ReplaceAllString(strings.ToLower(object.label), "_"), "_")
+
+	if name == "" {
+		return typeName + "_" + object.id
+	}
+
+	if name[0] >= '0' && name[0] <= '9' {
+		return "_" + name
+	}
+
+	return name
+}
+
+func (w *hclWriter) write(t exportType, objects []exportObject) ([]byte, error) {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+	resourceType := "zendesk_" + t.name
+
+	for i, object := range objects {
+		if i > 0 {
+			body.AppendNewline()
+		}
+
+		name := w.names[t.name][object.id]
+
+		block := body.AppendNewBlock("resource", []string{resourceType, name})
+		if err := w.writeAttributes(block.Body(), t, object.value); err != nil {
+			return nil, fmt.Errorf("writing %s.%s: %w", resourceType, name, err)
+		}
+
+		body.AppendNewline()
+
+		importBlock := body.AppendNewBlock("import", nil)
+		importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: resourceType},
+			hcl.TraverseAttr{Name: name},
+		})
+		importBlock.Body().SetAttributeValue("id", cty.StringVal(object.id))
+	}
+
+	return hclwrite.Format(file.Bytes()), nil
+}
+
+// writeAttributes writes every configurable attribute of value in alphabetical order.
+func (w *hclWriter) writeAttributes(body *hclwrite.Body, t exportType, value tftypes.Value) error {
+	var attrs map[string]tftypes.Value
+	if err := value.As(&attrs); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(t.schema.Attributes))
+	for name := range t.schema.Attributes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		attr := t.schema.Attributes[name]
+
+		if !isConfigurable(attr) || attrs[name].IsNull() || !attrs[name].IsKnown() {
+			continue
+		}
+
+		val, err := ctyValue(attr, attrs[name])
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		if refType, ok := t.references[name]; ok {
+			body.SetAttributeRaw(name, w.referenceTokens(refType, val))
+			continue
+		}
+
+		body.SetAttributeValue(name, val)
+	}
+
+	return nil
+}
+
+// referenceTokens renders an ID, or a list of IDs, replacing the IDs of exported objects
+// of refType with a reference to their id attribute.
+func (w *hclWriter) referenceTokens(refType string, val cty.Value) hclwrite.Tokens {
+	if val.Type().IsTupleType() {
+		var elems []hclwrite.Tokens
+		for it := val.ElementIterator(); it.Next(); {
+			_, elem := it.Element()
+			elems = append(elems, w.referenceTokens(refType, elem))
+		}
+		return hclwrite.TokensForTuple(elems)
+	}
+
+	var id string
+	switch val.Type() {
+	case cty.Number:
+		id = val.AsBigFloat().Text('f', -1)
+	case cty.String:
+		id = val.AsString()
+	}
+
+	name, ok := w.names[refType][id]
+	if !ok {
+		return hclwrite.TokensForValue(val)
+	}
+
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: "zendesk_" + refType},
+		hcl.TraverseAttr{Name: name},
+		hcl.TraverseAttr{Name: "id"},
+	})
+}
+
+func isConfigurable(attr schema.Attribute) bool {
+	return attr.IsRequired() || attr.IsOptional()
+}
+
+// nestedAttributes returns the attributes of the objects nested in attr, or nil if attr is
+// not a nested attribute.
+func nestedAttributes(attr schema.Attribute) map[string]schema.Attribute {
+	switch a := attr.(type) {
+	case schema.ListNestedAttribute:
+		return a.NestedObject.Attributes
+	case schema.SetNestedAttribute:
+		return a.NestedObject.Attributes
+	case schema.MapNestedAttribute:
+		return a.NestedObject.Attributes
+	case schema.SingleNestedAttribute:
+		return a.Attributes
+	}
+	return nil
+}
+
+// ctyValue converts a state value to its HCL value, leaving out null and computed only
+// attributes of nested objects. Lists and sets become tuples, as nested objects of a list
+// don't always share the same attributes once null ones are left out.
+func ctyValue(attr schema.Attribute, value tftypes.Value) (cty.Value, error) {
+	nested := nestedAttributes(attr)
+	typ := value.Type()
+
+	switch {
+	case value.IsNull():
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	case typ.Is(tftypes.String):
+		var s string
+		err := value.As(&s)
+		return cty.StringVal(s), err
+	case typ.Is(tftypes.Number):
+		f := new(big.Float)
+		err := value.As(&f)
+		return cty.NumberVal(f), err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := value.As(&b)
+		return cty.BoolVal(b), err
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := value.As(&elems); err != nil {
+			return cty.NilVal, err
+		}
+
+		vals := make([]cty.Value, 0, len(elems))
+		for _, elem := range elems {
+			val, err := ctyElementValue(attr, elem)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			vals = append(vals, val)
+		}
+
+		return cty.TupleVal(vals), nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		if err := value.As(&elems); err != nil {
+			return cty.NilVal, err
+		}
+
+		_, isMap := attr.(schema.MapNestedAttribute)
+		vals := make(map[string]cty.Value, len(elems))
+
+		for key, elem := range elems {
+			if elem.IsNull() || !elem.IsKnown() {
+				continue
+			}
+
+			var val cty.Value
+			var err error
+
+			switch {
+			case isMap && typ.Is(tftypes.Map{}):
+				val, err = ctyElementValue(attr, elem)
+			case nested != nil && typ.Is(tftypes.Object{}):
+				if nested[key] == nil || !isConfigurable(nested[key]) {
+					continue
+				}
+				val, err = ctyValue(nested[key], elem)
+			default:
+				val, err = ctyValue(attr, elem)
+			}
+
+			if err != nil {
+				return cty.NilVal, fmt.Errorf("%s: %w", strconv.Quote(key), err)
+			}
+			vals[key] = val
+		}
+
+		return cty.ObjectVal(vals), nil
+	}
+
+	return cty.NilVal, fmt.Errorf("unsupported value type %s", typ)
+}
+
+// ctyElementValue converts a list, set or map element, which is a nested object for nested
+// attributes.
+func ctyElementValue(attr schema.Attribute, elem tftypes.Value) (cty.Value, error) {
+	if nested := nestedAttributes(attr); nested != nil {
+		return ctyValue(schema.SingleNestedAttribute{Attributes: nested}, elem)
+	}
+	return ctyValue(attr, elem)
+}
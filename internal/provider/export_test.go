@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testTimestamps = `"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"`
+
+func testExportHandler(pages map[string]string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := pages[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	})
+}
+
+func TestExport(t *testing.T) {
+	zdClient := testZendeskClient(t, testExportHandler(map[string]string{
+		"/api/v2/trigger_categories.json": `{"trigger_categories":[{"id":"10","name":"Notifications","position":1,` + testTimestamps + `}]}`,
+		"/api/v2/triggers.json": `{"triggers":[{"id":1,"title":"Escalate VIP","active":true,"category_id":"10","position":1,` + testTimestamps + `,
+			"conditions":{"all":[{"field":"status","operator":"is","value":"new"}],"any":[]},
+			"actions":[{"field":"priority","value":"urgent"}]}]}`,
+		"/api/v2/ticket_fields.json": `{"ticket_fields":[
+			{"id":5,"type":"subject","title":"Subject","removable":false,` + testTimestamps + `},
+			{"id":6,"type":"text","title":"Account tier","removable":true,"active":true,` + testTimestamps + `}]}`,
+		"/api/v2/ticket_forms.json": `{"ticket_forms":[{"id":7,"name":"Default","ticket_field_ids":[5,6],"active":true,"end_user_visible":true,"in_all_brands":true,` + testTimestamps + `}]}`,
+	}))
+
+	dir := t.TempDir()
+
+	if err := Export(t.Context(), zdClient, []string{"trigger", "zendesk_trigger_category", "ticket_field", "ticket_form"}, dir); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"zendesk_trigger_category.tf": `resource "zendesk_trigger_category" "notifications" {
+  name     = "Notifications"
+  position = 1
+}
+
+import {
+  to = zendesk_trigger_category.notifications
+  id = "10"
+}
+`,
+		"zendesk_trigger.tf": `resource "zendesk_trigger" "escalate_vip" {
+  actions = [{
+    field = "priority"
+    value = "urgent"
+  }]
+  active      = true
+  category_id = zendesk_trigger_category.notifications.id
+  conditions = {
+    all = [{
+      field    = "status"
+      operator = "is"
+      value    = "new"
+    }]
+  }
+  description = ""
+  position    = 1
+  title       = "Escalate VIP"
+}
+
+import {
+  to = zendesk_trigger.escalate_vip
+  id = "1"
+}
+`,
+		"zendesk_ticket_field.tf": `resource "zendesk_ticket_field" "account_tier" {
+  active                = true
+  agent_description     = ""
+  editable_in_portal    = false
+  portal_description    = ""
+  position              = 0
+  regexp_for_validation = ""
+  required              = false
+  required_in_portal    = false
+  tag                   = ""
+  title                 = "Account tier"
+  title_in_portal       = ""
+  type                  = "text"
+  visible_in_portal     = false
+}
+
+import {
+  to = zendesk_ticket_field.account_tier
+  id = "6"
+}
+`,
+		"zendesk_ticket_form.tf": `resource "zendesk_ticket_form" "default" {
+  active           = true
+  default          = false
+  end_user_visible = true
+  form_name        = "Default"
+  in_all_brands    = true
+  position         = 0
+  ticket_field_ids = [5, zendesk_ticket_field.account_tier.id]
+}
+
+import {
+  to = zendesk_ticket_form.default
+  id = "7"
+}
+`,
+	}
+
+	for fileName, want := range expected {
+		got, err := os.ReadFile(filepath.Join(dir, fileName))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if diff := cmp.Diff(want, string(got)); diff != "" {
+			t.Errorf("unexpected %s (-want +got):\n%s", fileName, diff)
+		}
+	}
+}
+
+func TestExport_UnknownType(t *testing.T) {
+	zdClient := testZendeskClient(t, testExportHandler(nil))
+
+	err := Export(t.Context(), zdClient, []string{"trigger", "ticket"}, t.TempDir())
+
+	if err == nil {
+		t.Fatal("expected an error for an unknown type")
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	cases := []struct {
+		testName string
+		label    string
+		expected string
+	}{
+		{testName: "should snake case labels", label: "Escalate VIP - Tier 1", expected: "escalate_vip_tier_1"},
+		{testName: "should prefix leading digits", label: "24/7 support", expected: "_24_7_support"},
+		{testName: "should fall back on the id", label: "日本語", expected: "group_42"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			if got := resourceName("group", exportObject{id: "42", label: c.label}); got != c.expected {
+				t.Fatalf("expected %s, got %s", c.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.ResourceWithImportState = &GroupMembershipResource{}
+var _ resource.ResourceWithConfigure = &GroupMembershipResource{}
+
+type GroupMembershipResource struct {
+	client *zendeskapi.Client
+}
+
+func NewGroupMembershipResource() resource.Resource {
+	return &GroupMembershipResource{}
+}
+
+func (m *GroupMembershipResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_group_membership"
+}
+
+func (m *GroupMembershipResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*zendesk.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendesk.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	m.client = zendeskapi.NewClient(client)
+}
+
+func (m *GroupMembershipResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = GroupMembershipSchema
+}
+
+// Create makes the membership the default one in a second call when Zendesk ignored the flag.
+func (m *GroupMembershipResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	models.CreateResource(ctx, request, response, &models.GroupMembershipResourceModel{}, func(ctx context.Context, newMembership zendesk.GroupMembership) (zendesk.GroupMembership, error) {
+		membership, err := m.client.CreateGroupMembership(ctx, newMembership)
+		if err != nil || !newMembership.Default || membership.Default {
+			return membership, err
+		}
+
+		return m.makeDefault(ctx, membership)
+	})
+}
+
+func (m *GroupMembershipResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	models.ReadResource(ctx, request, response, &models.GroupMembershipResourceModel{}, m.client.GetGroupMembership)
+}
+
+// Update only changes the default flag, the user and group recreate the membership.
+func (m *GroupMembershipResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	models.UpdateResource(ctx, request, response, &models.GroupMembershipResourceModel{}, func(ctx context.Context, id int64, updatedMembership zendesk.GroupMembership) (zendesk.GroupMembership, error) {
+		membership, err := m.client.GetGroupMembership(ctx, id)
+		if err != nil || membership.Default == updatedMembership.Default {
+			return membership, err
+		}
+
+		if !updatedMembership.Default {
+			return membership, errors.New("the default membership of an agent can't be unset, make another membership of the agent the default instead")
+		}
+
+		return m.makeDefault(ctx, membership)
+	})
+}
+
+func (m *GroupMembershipResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	models.DeleteResource[zendesk.GroupMembership](ctx, request, response, &models.GroupMembershipResourceModel{}, m.client.DeleteGroupMembership)
+}
+
+// ImportState imports a membership with an ID formatted as <group_id>/<user_id>.
+func (m *GroupMembershipResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	groupID, userID, err := parseGroupMembershipID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("Invalid import id", err.Error())
+		return
+	}
+
+	memberships, err := m.client.GetGroupMembershipsByGroup(ctx, groupID)
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error importing resource", err, GroupMembershipSchema)...)
+		return
+	}
+
+	for _, membership := range memberships {
+		if membership.UserID != userID {
+			continue
+		}
+
+		data := models.GroupMembershipResourceModel{}
+
+		response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, membership)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+		return
+	}
+
+	response.Diagnostics.AddError("Error importing resource", fmt.Sprintf("User %d is not a member of group %d.", userID, groupID))
+}
+
+func (m *GroupMembershipResource) makeDefault(ctx context.Context, membership zendesk.GroupMembership) (zendesk.GroupMembership, error) {
+	if err := m.client.MakeGroupMembershipDefault(ctx, membership.UserID, membership.ID); err != nil {
+		return membership, err
+	}
+
+	return m.client.GetGroupMembership(ctx, membership.ID)
+}
+
+// parseGroupMembershipID splits a <group_id>/<user_id> import ID.
+func parseGroupMembershipID(id string) (groupID int64, userID int64, err error) {
+	groupIDStr, userIDStr, found := strings.Cut(id, "/")
+	if found {
+		groupID, err = strconv.ParseInt(groupIDStr, 10, 64)
+	}
+	if found && err == nil {
+		userID, err = strconv.ParseInt(userIDStr, 10, 64)
+	}
+	if !found || err != nil {
+		return 0, 0, fmt.Errorf("import id %q must be formatted as <group_id>/<user_id>, ex: 123/456", id)
+	}
+
+	return groupID, userID, nil
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+var dummyGroupMembershipResourceName = "zendesk_group_membership.test"
+
+func TestAccGroupMembership(t *testing.T) {
+	t.Parallel()
+
+	t.Run("basic membership", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyGroupMembershipResourceName,
+							tfjsonpath.New("default"),
+							knownvalue.Bool(true),
+						),
+					},
+				},
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ImportState:       true,
+					ImportStateVerify: true,
+					ImportStateIdFunc: func(s *terraform.State) (string, error) {
+						rs := s.RootModule().Resources[dummyGroupMembershipResourceName]
+						return rs.Primary.Attributes["group_id"] + "/" + rs.Primary.Attributes["user_id"], nil
+					},
+					ResourceName: dummyGroupMembershipResourceName,
+				},
+			},
+		})
+	})
+}
+
+func TestParseGroupMembershipID(t *testing.T) {
+	cases := []struct {
+		testName    string
+		input       string
+		group       int64
+		user        int64
+		expectError bool
+	}{
+		{testName: "should parse group and user ids", input: "123/456", group: 123, user: 456},
+		{testName: "should reject a membership id alone", input: "456", expectError: true},
+		{testName: "should reject non numeric ids", input: "123/agent@example.com", expectError: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			groupID, userID, err := parseGroupMembershipID(c.input)
+
+			if (err != nil) != c.expectError {
+				t.Fatalf("expected error %t, got %v", c.expectError, err)
+			}
+
+			if groupID != c.group || userID != c.user {
+				t.Fatalf("expected %d/%d, got %d/%d", c.group, c.user, groupID, userID)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var GroupMembershipSchema = schema.Schema{
+	Version: 0,
+	MarkdownDescription: "Membership of an agent in a group. Use `zendesk_group_memberships` instead to manage every member of a group, " +
+		"the two resources should not be used for the same group. " +
+		"See [Group Memberships](https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/) for more information.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"user_id": schema.Int64Attribute{
+			Required:    true,
+			Description: "ID of the agent. Changing the agent will recreate the resource.",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"group_id": schema.Int64Attribute{
+			Required:    true,
+			Description: "ID of the group. Changing the group will recreate the resource.",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"default": schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+			Description: "If true, the group is the default group of the agent. Setting it makes the previous default " +
+				"membership of the agent lose its flag. It can't be unset, make another membership the default instead.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"url": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Description: "The URL for this resource",
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The time the membership was created.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The time of the last update of the membership.",
+			Computed:    true,
+		},
+	},
+}
+
+var GroupMembershipsSchema = schema.Schema{
+	Version: 0,
+	MarkdownDescription: "Every member of a group, agents not listed are removed from the group. " +
+		"Use `zendesk_group_membership` instead to manage single memberships, the two resources should not be used for the same group. " +
+		"See [Group Memberships](https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/) for more information.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "ID of the group",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"group_id": schema.Int64Attribute{
+			Required:    true,
+			Description: "ID of the group. Changing the group will recreate the resource.",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"user_ids": schema.SetAttribute{
+			Required:    true,
+			ElementType: types.Int64Type,
+			Description: "IDs of the agents members of the group. Destroying the resource removes them from the group.",
+		},
+	},
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithImportState = &GroupMembershipsResource{}
+var _ resource.ResourceWithConfigure = &GroupMembershipsResource{}
+
+// GroupMembershipsResource manages every member of a group, the group ID is the ID of the
+// resource.
+type GroupMembershipsResource struct {
+	client *zendeskapi.Client
+}
+
+func NewGroupMembershipsResource() resource.Resource {
+	return &GroupMembershipsResource{}
+}
+
+func (m *GroupMembershipsResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_group_memberships"
+}
+
+func (m *GroupMembershipsResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*zendesk.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendesk.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	m.client = zendeskapi.NewClient(client)
+}
+
+func (m *GroupMembershipsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = GroupMembershipsSchema
+}
+
+func (m *GroupMembershipsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	data := &models.GroupMembershipsResourceModel{}
+	models.CreateResource(ctx, request, response, data, func(ctx context.Context, memberships []zendesk.GroupMembership) ([]zendesk.GroupMembership, error) {
+		return m.reconcile(ctx, data.GetID(), memberships)
+	})
+}
+
+func (m *GroupMembershipsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	models.ReadResource(ctx, request, response, &models.GroupMembershipsResourceModel{}, m.client.GetGroupMembershipsByGroup)
+}
+
+func (m *GroupMembershipsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	models.UpdateResource(ctx, request, response, &models.GroupMembershipsResourceModel{}, m.reconcile)
+}
+
+// Delete removes the members in the state from the group, members added since the last
+// refresh are kept.
+func (m *GroupMembershipsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	data := &models.GroupMembershipsResourceModel{}
+	models.DeleteResource[[]zendesk.GroupMembership](ctx, request, response, data, func(ctx context.Context, groupID int64) error {
+		userIDs, diags := data.GetUserIDs(ctx)
+		if diags.HasError() {
+			return errors.New("invalid user_ids in state")
+		}
+
+		current, err := m.client.GetGroupMembershipsByGroup(ctx, groupID)
+		if err != nil {
+			return err
+		}
+
+		for _, membership := range current {
+			if !slices.Contains(userIDs, membership.UserID) {
+				continue
+			}
+
+			if err := m.client.DeleteGroupMembership(ctx, membership.ID); err != nil && !models.IsNotFound(err) {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ImportState imports the members of a group with the group ID.
+func (m *GroupMembershipsResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	groupID, err := strconv.ParseInt(request.ID, 10, 64)
+	if err != nil {
+		response.Diagnostics.AddError("Unable to convert import id", fmt.Sprintf("error converting value %s to int64", request.ID))
+		return
+	}
+
+	memberships, err := m.client.GetGroupMembershipsByGroup(ctx, groupID)
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error importing resource", err, GroupMembershipsSchema)...)
+		return
+	}
+
+	data := models.GroupMembershipsResourceModel{
+		GroupID: types.Int64Value(groupID),
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, memberships)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// reconcile adds the missing members to the group before removing the members not listed, so
+// agents moved between groups always keep one group, and returns the resulting memberships.
+func (m *GroupMembershipsResource) reconcile(ctx context.Context, groupID int64, memberships []zendesk.GroupMembership) ([]zendesk.GroupMembership, error) {
+	current, err := m.client.GetGroupMembershipsByGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentUserIDs := make(map[int64]bool, len(current))
+	for _, membership := range current {
+		currentUserIDs[membership.UserID] = true
+	}
+
+	wantedUserIDs := make(map[int64]bool, len(memberships))
+	for _, membership := range memberships {
+		wantedUserIDs[membership.UserID] = true
+
+		if currentUserIDs[membership.UserID] {
+			continue
+		}
+
+		if _, err := m.client.CreateGroupMembership(ctx, membership); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, membership := range current {
+		if wantedUserIDs[membership.UserID] {
+			continue
+		}
+
+		if err := m.client.DeleteGroupMembership(ctx, membership.ID); err != nil && !models.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return m.client.GetGroupMembershipsByGroup(ctx, groupID)
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+)
+
+var dummyGroupMembershipsResourceName = "zendesk_group_memberships.test"
+
+func TestAccGroupMemberships(t *testing.T) {
+	t.Parallel()
+
+	t.Run("basic memberships", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyGroupMembershipsResourceName,
+							tfjsonpath.New("user_ids"),
+							knownvalue.SetSizeExact(2),
+						),
+					},
+				},
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ImportState:       true,
+					ImportStateVerify: true,
+					ResourceName:      dummyGroupMembershipsResourceName,
+				},
+			},
+		})
+	})
+
+	t.Run("update memberships", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyGroupMembershipsResourceName,
+							tfjsonpath.New("user_ids"),
+							knownvalue.SetSizeExact(2),
+						),
+					},
+				},
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyGroupMembershipsResourceName,
+							tfjsonpath.New("user_ids"),
+							knownvalue.SetSizeExact(1),
+						),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestGroupMembershipsResource_Update(t *testing.T) {
+	var requests []string
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"group_memberships":[{"id":1,"user_id":10,"group_id":5},{"id":2,"user_id":20,"group_id":5}]}`))
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"group_membership":{"id":3,"user_id":30,"group_id":5}}`))
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	r := &GroupMembershipsResource{client: zendeskapi.NewClient(testZendeskClient(t, handler))}
+
+	userIDs := tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, []tftypes.Value{
+		tftypes.NewValue(tftypes.Number, 10),
+		tftypes.NewValue(tftypes.Number, 30),
+	})
+	plan := testResourceState(t, GroupMembershipsSchema, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.Number, 5),
+		"group_id": tftypes.NewValue(tftypes.Number, 5),
+		"user_ids": userIDs,
+	})
+	response := &fwresource.UpdateResponse{State: plan}
+
+	r.Update(t.Context(), fwresource.UpdateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, State: plan}, response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", response.Diagnostics)
+	}
+
+	expectedRequests := []string{
+		"GET /api/v2/groups/5/memberships.json",
+		"POST /api/v2/group_memberships.json",
+		"DELETE /api/v2/group_memberships/2.json",
+		"GET /api/v2/groups/5/memberships.json",
+	}
+	if !slices.Equal(requests, expectedRequests) {
+		t.Fatalf("expected requests %v, got %v", expectedRequests, requests)
+	}
+}
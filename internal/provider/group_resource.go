@@ -63,5 +63,17 @@ func (g *GroupResource) Delete(ctx context.Context, request resource.DeleteReque
 }
 
 func (g *GroupResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	models.ImportResource(ctx, request, response, &models.GroupResourceModel{}, g.client.GetGroup)
+	models.ImportResource(ctx, request, response, &models.GroupResourceModel{}, g.client.GetGroup, groupImportLookup(g.client))
+}
+
+func groupImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.Group] {
+	return models.ImportLookup[zendesk.Group]{
+		List: func(ctx context.Context) ([]zendesk.Group, error) {
+			return listAll[zendesk.Group](ctx, zdClient, "/groups.json", "groups")
+		},
+		ID: func(group zendesk.Group) string { return formatID(group.ID) },
+		Attributes: map[string]func(zendesk.Group) string{
+			"name": func(group zendesk.Group) string { return group.Name },
+		},
+	}
 }
@@ -2,6 +2,8 @@ package provider
 
 import (
 	"fmt"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -66,3 +68,22 @@ func TestAccGroup(t *testing.T) {
 		})
 	})
 }
+
+func TestGroupResource_ReadNotFound(t *testing.T) {
+	r := &GroupResource{client: testZendeskClient(t, testNotFoundHandler)}
+
+	state := testResourceState(t, GroupSchema, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.Number, 123),
+	})
+	response := &fwresource.ReadResponse{State: state}
+
+	r.Read(t.Context(), fwresource.ReadRequest{State: state}, response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", response.Diagnostics)
+	}
+
+	if !response.State.Raw.IsNull() {
+		t.Fatalf("expected resource to be removed from state, got %s", response.State.Raw)
+	}
+}
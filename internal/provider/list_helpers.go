@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/JacobPotter/go-zendesk/client"
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+const listPageSize = "100"
+
+// listAll fetches every item of a Zendesk list endpoint, ex: /triggers.json, decoding the
+// items found under key. The endpoint may carry filters, ex: /users.json?role=agent. Cursor pagination is requested, and offset pagination is followed
+// for endpoints without cursor support.
+func listAll[T any](ctx context.Context, zdClient *zendesk.Client, endpoint string, key string) ([]T, error) {
+	var items []T
+
+	endpoint, rawQuery, _ := strings.Cut(endpoint, "?")
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	query.Set("page[size]", listPageSize)
+	next := endpoint + "?" + query.Encode()
+
+	for next != "" {
+		body, err := zdClient.Get(ctx, next)
+		if err != nil {
+			return nil, err
+		}
+
+		var page map[string]json.RawMessage
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, err
+		}
+
+		var pageItems []T
+		if err := json.Unmarshal(page[key], &pageItems); page[key] != nil && err != nil {
+			return nil, err
+		}
+		items = append(items, pageItems...)
+
+		var meta client.CursorPaginationMeta
+		if page["meta"] != nil {
+			if err := json.Unmarshal(page["meta"], &meta); err != nil {
+				return nil, err
+			}
+		}
+
+		var nextPage string
+		if page["next_page"] != nil {
+			// next_page is null on the last page, leaving nextPage empty
+			_ = json.Unmarshal(page["next_page"], &nextPage)
+		}
+
+		switch {
+		case meta.HasMore && meta.AfterCursor != "":
+			query.Set("page[after]", meta.AfterCursor)
+			next = endpoint + "?" + query.Encode()
+		case nextPage != "":
+			next, err = relativePagePath(zdClient, nextPage)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			next = ""
+		}
+	}
+
+	return items, nil
+}
+
+// relativePagePath returns the path of an absolute next_page URL relative to the client
+// base URL.
+func relativePagePath(zdClient *zendesk.Client, nextPage string) (string, error) {
+	base := strings.TrimSuffix(zdClient.BaseURL.String(), "/")
+
+	if !strings.HasPrefix(nextPage, base) {
+		nextURL, err := url.Parse(nextPage)
+		if err != nil {
+			return "", err
+		}
+
+		// fall back on the path when the API answers with another host, ex: behind a proxy
+		if !strings.HasPrefix(nextURL.Path, zdClient.BaseURL.Path) {
+			return "", fmt.Errorf("unexpected next page %s", nextPage)
+		}
+
+		return strings.TrimPrefix(nextURL.RequestURI(), zdClient.BaseURL.Path), nil
+	}
+
+	return strings.TrimPrefix(nextPage, base), nil
+}
+
+// formatID formats a numeric Zendesk ID for an ImportLookup.
+func formatID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+func TestListAll(t *testing.T) {
+	cases := []struct {
+		testName string
+		pages    map[string]string
+		expected []int64
+	}{
+		{
+			testName: "should follow cursor pagination",
+			pages: map[string]string{
+				"":    `{"groups":[{"id":1},{"id":2}],"meta":{"has_more":true,"after_cursor":"abc"}}`,
+				"abc": `{"groups":[{"id":3}],"meta":{"has_more":false}}`,
+			},
+			expected: []int64{1, 2, 3},
+		},
+		{
+			testName: "should follow offset pagination",
+			pages: map[string]string{
+				"":  `{"groups":[{"id":1}],"next_page":"{{server}}/api/v2/groups.json?page=2"}`,
+				"2": `{"groups":[{"id":2}],"next_page":null}`,
+			},
+			expected: []int64{1, 2},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			var serverURL string
+
+			zdClient := testZendeskClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				page := r.URL.Query().Get("page[after]") + r.URL.Query().Get("page")
+				body, ok := c.pages[page]
+				if r.URL.Path != "/api/v2/groups.json" || !ok {
+					http.NotFound(w, r)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(strings.ReplaceAll(body, "{{server}}", serverURL)))
+			}))
+			serverURL = zdClient.BaseURL.Scheme + "://" + zdClient.BaseURL.Host
+
+			groups, err := listAll[zendesk.Group](t.Context(), zdClient, "/groups.json", "groups")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var ids []int64
+			for _, group := range groups {
+				ids = append(ids, group.ID)
+			}
+
+			if !reflect.DeepEqual(ids, c.expected) {
+				t.Fatalf("expected ids %v, got %v", c.expected, ids)
+			}
+		})
+	}
+}
+
+func TestListAll_EndpointQuery(t *testing.T) {
+	var query url.Values
+
+	zdClient := testZendeskClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"users":[{"id":1}],"meta":{"has_more":false}}`))
+	}))
+
+	if _, err := listAll[zendesk.User](t.Context(), zdClient, "/users.json?role[]=agent&role[]=admin", "users"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(query["role[]"], []string{"agent", "admin"}) || query.Get("page[size]") != listPageSize {
+		t.Fatalf("unexpected query %v", query)
+	}
+}
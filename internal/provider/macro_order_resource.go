@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// NewMacroOrderResource manages the order of the macros, there is one per account.
+func NewMacroOrderResource() resource.Resource {
+	return &orderResource[zendesk.Macro, int64]{
+		typeName:  "_macro_order",
+		id:        models.MacroOrderID,
+		attribute: "macro_ids",
+		items:     "macros",
+		schema:    MacroOrderSchema,
+		list: func(ctx context.Context, client *zendeskapi.Client) ([]zendesk.Macro, error) {
+			return listAll[zendesk.Macro](ctx, client.Client, "/macros.json", "macros")
+		},
+		currentOrder: models.MacroIDsByPosition,
+		update: func(ctx context.Context, client *zendeskapi.Client, ids []int64) error {
+			return client.UpdateMacroPositions(ctx, ids)
+		},
+	}
+}
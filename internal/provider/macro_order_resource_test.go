@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+var dummyMacroOrderResourceName = "zendesk_macro_order.test"
+
+func TestAccMacroOrder(t *testing.T) {
+	t.Run("reorder macros", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: config.Variables{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.CompareValuePairs(
+							dummyMacroOrderResourceName,
+							tfjsonpath.New("macro_ids").AtSliceIndex(0),
+							"zendesk_macro.first",
+							tfjsonpath.New("id"),
+							compare.ValuesSame(),
+						),
+					},
+				},
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: config.Variables{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.CompareValuePairs(
+							dummyMacroOrderResourceName,
+							tfjsonpath.New("macro_ids").AtSliceIndex(0),
+							"zendesk_macro.second",
+							tfjsonpath.New("id"),
+							compare.ValuesSame(),
+						),
+					},
+				},
+			},
+		})
+	})
+}
@@ -68,5 +68,17 @@ func (r *MacroResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *MacroResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	models.ImportResource(ctx, req, resp, &models.MacroResourceModel{}, r.client.GetMacro)
+	models.ImportResource(ctx, req, resp, &models.MacroResourceModel{}, r.client.GetMacro, macroImportLookup(r.client))
+}
+
+func macroImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.Macro] {
+	return models.ImportLookup[zendesk.Macro]{
+		List: func(ctx context.Context) ([]zendesk.Macro, error) {
+			return listAll[zendesk.Macro](ctx, zdClient, "/macros.json", "macros")
+		},
+		ID: func(macro zendesk.Macro) string { return formatID(macro.ID) },
+		Attributes: map[string]func(zendesk.Macro) string{
+			"title": func(macro zendesk.Macro) string { return macro.Title },
+		},
+	}
 }
@@ -53,9 +53,10 @@ var MacroSchema = schema.Schema{
 			Computed:    true,
 		},
 		"position": schema.Int64Attribute{
-			Optional:    true,
-			Computed:    true,
-			Description: "The position of a macro.",
+			Optional: true,
+			Computed: true,
+			Description: "The position of a macro. " +
+				"Use zendesk_macro_order instead to order several macros.",
 		},
 		"created_at": schema.StringAttribute{
 			Description: "The time the macro was created.",
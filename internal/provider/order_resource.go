@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithImportState = &orderResource[any, int64]{}
+var _ resource.ResourceWithConfigure = &orderResource[any, int64]{}
+
+// orderResource manages the order of every item of a kind, there is one per account. The listed
+// items come first, the others keep their relative order after them. Each order resource is an
+// orderResource built by its New<Resource> function.
+type orderResource[T any, ID comparable] struct {
+	client *zendeskapi.Client
+
+	// typeName is appended to the provider type name, e.g. _view_order.
+	typeName string
+	// id is the ID of the resource, it is also the only accepted import ID.
+	id string
+	// attribute is the list attribute holding the IDs in order, e.g. view_ids.
+	attribute string
+	// items names the items in the error messages, e.g. views.
+	items  string
+	schema schema.Schema
+
+	// list returns every item of the account.
+	list func(ctx context.Context, client *zendeskapi.Client) ([]T, error)
+	// currentOrder returns the IDs of the items in their current order.
+	currentOrder func(items []T) []ID
+	// update sends the order of every item in one bulk update.
+	update func(ctx context.Context, client *zendeskapi.Client, ids []ID) error
+}
+
+func (o *orderResource[T, ID]) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + o.typeName
+}
+
+func (o *orderResource[T, ID]) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*zendesk.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendesk.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	o.client = zendeskapi.NewClient(client)
+}
+
+func (o *orderResource[T, ID]) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = o.schema
+}
+
+func (o *orderResource[T, ID]) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var listed types.List
+
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(o.attribute), &listed)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ids, diags := models.OrderedIDs[ID](ctx, listed)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := o.reorder(ctx, ids)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error reordering "+o.items, err, request.Plan.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(o.setState(ctx, &response.State, listed, items)...)
+}
+
+func (o *orderResource[T, ID]) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var listed types.List
+
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(o.attribute), &listed)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := o.list(ctx, o.client)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error reading "+o.items, err, request.State.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(o.setState(ctx, &response.State, listed, items)...)
+}
+
+func (o *orderResource[T, ID]) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var listed types.List
+
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(o.attribute), &listed)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ids, diags := models.OrderedIDs[ID](ctx, listed)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := o.reorder(ctx, ids)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error reordering "+o.items, err, request.Plan.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(o.setState(ctx, &response.State, listed, items)...)
+}
+
+// Delete only removes the resource from the state, the items keep their current order.
+func (o *orderResource[T, ID]) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+}
+
+// ImportState imports the order of every item of the account, the import ID is the ID of the
+// resource.
+func (o *orderResource[T, ID]) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	if request.ID != o.id {
+		response.Diagnostics.AddError("Invalid import id", fmt.Sprintf("the order of the %s is imported with the id %s, got %s", o.items, o.id, request.ID))
+		return
+	}
+
+	items, err := o.list(ctx, o.client)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error importing resource", err, response.State.Schema)...)
+		return
+	}
+
+	elementType := o.schema.Attributes[o.attribute].GetType().(types.ListType).ElemType
+
+	response.Diagnostics.Append(o.setState(ctx, &response.State, types.ListNull(elementType), items)...)
+}
+
+// reorder sends the order of every item in one bulk update, items not listed keep their relative
+// order after the listed ones. The items are read again to get their new positions.
+func (o *orderResource[T, ID]) reorder(ctx context.Context, ids []ID) ([]T, error) {
+	items, err := o.list(ctx, o.client)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.update(ctx, o.client, completeOrder(ids, o.currentOrder(items)))
+	if err != nil {
+		return nil, err
+	}
+
+	return o.list(ctx, o.client)
+}
+
+// setState sets the listed IDs in their current order, see models.ListedIDOrder.
+func (o *orderResource[T, ID]) setState(ctx context.Context, state *tfsdk.State, listed types.List, items []T) (diags diag.Diagnostics) {
+	idList, diags := models.ListedIDOrder(ctx, listed, o.currentOrder(items))
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), types.StringValue(o.id))...)
+	diags.Append(state.SetAttribute(ctx, path.Root(o.attribute), idList)...)
+
+	return diags
+}
+
+// completeOrder appends the current IDs missing from ids, in their current order.
+func completeOrder[T comparable](ids []T, currentIDs []T) []T {
+	order := slices.Clone(ids)
+
+	for _, id := range currentIDs {
+		if !slices.Contains(ids, id) {
+			order = append(order, id)
+		}
+	}
+
+	return order
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestOrderResource_Update(t *testing.T) {
+	int64IDs := []tftypes.Value{tftypes.NewValue(tftypes.Number, 3), tftypes.NewValue(tftypes.Number, 1)}
+	int64State := types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(3), types.Int64Value(1)})
+
+	cases := []struct {
+		testName       string
+		resource       func() fwresource.Resource
+		id             string
+		attribute      string
+		listed         tftypes.Value
+		items          string
+		reorderedItems string
+		listPath       string
+		updateRequest  string
+		expectedBody   string
+		expected       types.List
+	}{
+		{
+			testName:       "ticket form order",
+			resource:       NewTicketFormOrderResource,
+			id:             "ticket_form_order",
+			attribute:      "ticket_form_ids",
+			listed:         tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, int64IDs),
+			items:          `{"ticket_forms":[{"id":1,"position":0},{"id":2,"position":1},{"id":3,"position":2}],"meta":{"has_more":false}}`,
+			reorderedItems: `{"ticket_forms":[{"id":3,"position":0},{"id":1,"position":1},{"id":2,"position":2}],"meta":{"has_more":false}}`,
+			listPath:       "/api/v2/ticket_forms.json",
+			updateRequest:  "PUT /api/v2/ticket_forms/reorder.json",
+			expectedBody:   `{"ticket_form_ids":[3,1,2]}`,
+			expected:       int64State,
+		},
+		{
+			testName:       "view order",
+			resource:       NewViewOrderResource,
+			id:             "view_order",
+			attribute:      "view_ids",
+			listed:         tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, int64IDs),
+			items:          `{"views":[{"id":1,"position":0},{"id":2,"position":1},{"id":3,"position":2}],"meta":{"has_more":false}}`,
+			reorderedItems: `{"views":[{"id":3,"position":0},{"id":1,"position":1},{"id":2,"position":2}],"meta":{"has_more":false}}`,
+			listPath:       "/api/v2/views.json",
+			updateRequest:  "PUT /api/v2/views/update_many.json",
+			expectedBody:   `{"views":[{"id":3,"position":0},{"id":1,"position":1},{"id":2,"position":2}]}`,
+			expected:       int64State,
+		},
+		{
+			testName:       "macro order",
+			resource:       NewMacroOrderResource,
+			id:             "macro_order",
+			attribute:      "macro_ids",
+			listed:         tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, int64IDs),
+			items:          `{"macros":[{"id":1,"position":0},{"id":2,"position":1},{"id":3,"position":2}],"meta":{"has_more":false}}`,
+			reorderedItems: `{"macros":[{"id":3,"position":0},{"id":1,"position":1},{"id":2,"position":2}],"meta":{"has_more":false}}`,
+			listPath:       "/api/v2/macros.json",
+			updateRequest:  "PUT /api/v2/macros/update_many.json",
+			expectedBody:   `{"macros":[{"id":3,"position":0},{"id":1,"position":1},{"id":2,"position":2}]}`,
+			expected:       int64State,
+		},
+		{
+			testName:  "routing queue order",
+			resource:  NewRoutingQueueOrderResource,
+			id:        "routing_queue_order",
+			attribute: "queue_ids",
+			listed: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "c"),
+				tftypes.NewValue(tftypes.String, "a"),
+			}),
+			items:          `{"queues":[{"id":"a","order":1},{"id":"b","order":2},{"id":"c","order":3}]}`,
+			reorderedItems: `{"queues":[{"id":"c","order":1},{"id":"a","order":2},{"id":"b","order":3}]}`,
+			listPath:       "/api/v2/queues.json",
+			updateRequest:  "PATCH /api/v2/queues/order.json",
+			expectedBody:   `{"queue_ids":["c","a","b"]}`,
+			expected:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("c"), types.StringValue("a")}),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			var requests []string
+			var updateBody string
+
+			items := c.items
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+
+				w.Header().Set("Content-Type", "application/json")
+				switch r.Method {
+				case http.MethodGet:
+					_, _ = w.Write([]byte(items))
+				default:
+					body, _ := io.ReadAll(r.Body)
+					updateBody = string(body)
+					items = c.reorderedItems
+					_, _ = w.Write([]byte(items))
+				}
+			})
+
+			r := c.resource()
+			r.(fwresource.ResourceWithConfigure).Configure(t.Context(), fwresource.ConfigureRequest{ProviderData: testZendeskClient(t, handler)}, &fwresource.ConfigureResponse{})
+
+			schemaResponse := &fwresource.SchemaResponse{}
+			r.Schema(t.Context(), fwresource.SchemaRequest{}, schemaResponse)
+
+			plan := testResourceState(t, schemaResponse.Schema, map[string]tftypes.Value{
+				"id":        tftypes.NewValue(tftypes.String, c.id),
+				c.attribute: c.listed,
+			})
+			response := &fwresource.UpdateResponse{State: plan}
+
+			r.Update(t.Context(), fwresource.UpdateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, State: plan}, response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %+v", response.Diagnostics)
+			}
+
+			expectedRequests := []string{
+				"GET " + c.listPath,
+				c.updateRequest,
+				"GET " + c.listPath,
+			}
+			if !slices.Equal(requests, expectedRequests) {
+				t.Fatalf("expected requests %v, got %v", expectedRequests, requests)
+			}
+
+			if updateBody != c.expectedBody {
+				t.Fatalf("expected the unlisted item after the listed ones, got %s", updateBody)
+			}
+
+			var ids types.List
+			response.Diagnostics.Append(response.State.GetAttribute(t.Context(), path.Root(c.attribute), &ids)...)
+
+			if !ids.Equal(c.expected) {
+				t.Fatalf("expected %s %v, got %v", c.attribute, c.expected, ids)
+			}
+		})
+	}
+}
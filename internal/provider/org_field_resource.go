@@ -119,6 +119,19 @@ func (r *OrganizationFieldResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *OrganizationFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	models.ImportResource(ctx, req, resp, &models.OrganizationFieldResourceModel{}, r.client.GetOrganizationField)
+	models.ImportResource(ctx, req, resp, &models.OrganizationFieldResourceModel{}, r.client.GetOrganizationField, organizationFieldImportLookup(r.client))
 
 }
+
+func organizationFieldImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.OrganizationField] {
+	return models.ImportLookup[zendesk.OrganizationField]{
+		List: func(ctx context.Context) ([]zendesk.OrganizationField, error) {
+			return listAll[zendesk.OrganizationField](ctx, zdClient, "/organization_fields.json", "organization_fields")
+		},
+		ID: func(field zendesk.OrganizationField) string { return formatID(field.ID) },
+		Attributes: map[string]func(zendesk.OrganizationField) string{
+			"key":   func(field zendesk.OrganizationField) string { return field.Key },
+			"title": func(field zendesk.OrganizationField) string { return field.Title },
+		},
+	}
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.ResourceWithImportState = &OrganizationResource{}
+var _ resource.ResourceWithConfigure = &OrganizationResource{}
+
+type OrganizationResource struct {
+	client *zendeskapi.Client
+}
+
+func NewOrganizationResource() resource.Resource {
+	return &OrganizationResource{}
+}
+
+func (o *OrganizationResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*zendesk.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendesk.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	o.client = zendeskapi.NewClient(client)
+}
+
+func (o *OrganizationResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_organization"
+}
+
+func (o *OrganizationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = OrganizationSchema
+}
+
+func (o *OrganizationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	models.CreateResource(ctx, request, response, &models.OrganizationResourceModel{}, o.client.CreateOrganization)
+}
+
+func (o *OrganizationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	models.ReadResource(ctx, request, response, &models.OrganizationResourceModel{}, o.client.GetOrganization)
+}
+
+func (o *OrganizationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	models.UpdateResource(ctx, request, response, &models.OrganizationResourceModel{}, o.client.UpdateOrganization)
+}
+
+func (o *OrganizationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	models.DeleteResource[zendesk.Organization](ctx, request, response, &models.OrganizationResourceModel{}, o.client.DeleteOrganization)
+}
+
+func (o *OrganizationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	models.ImportResource(ctx, request, response, &models.OrganizationResourceModel{}, o.client.GetOrganization, organizationImportLookup(o.client.Client))
+}
+
+func organizationImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.Organization] {
+	return models.ImportLookup[zendesk.Organization]{
+		List: func(ctx context.Context) ([]zendesk.Organization, error) {
+			return listAll[zendesk.Organization](ctx, zdClient, "/organizations.json", "organizations")
+		},
+		ID: func(organization zendesk.Organization) string { return formatID(organization.ID) },
+		Attributes: map[string]func(zendesk.Organization) string{
+			"external_id": func(organization zendesk.Organization) string { return organization.ExternalID },
+			"name":        func(organization zendesk.Organization) string { return organization.Name },
+		},
+	}
+}
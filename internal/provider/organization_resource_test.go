@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"testing"
+)
+
+var dummyOrganizationResourceName = "zendesk_organization.test"
+
+func TestAccOrganization(t *testing.T) {
+	t.Parallel()
+
+	t.Run("basic_organization", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyOrganizationResourceName,
+							tfjsonpath.New("name"),
+							knownvalue.StringExact(fullResourceName),
+						),
+						statecheck.ExpectKnownValue(
+							dummyOrganizationResourceName,
+							tfjsonpath.New("tags"),
+							knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("terraform")}),
+						),
+					},
+				},
+				{
+					ResourceName:      dummyOrganizationResourceName,
+					ImportState:       true,
+					ImportStateId:     "external_id:" + fullResourceName,
+					ImportStateVerify: true,
+					ConfigFile:        config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("update_organization", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyOrganizationResourceName,
+							tfjsonpath.New("shared_tickets"),
+							knownvalue.Bool(false),
+						),
+					},
+				},
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyOrganizationResourceName,
+							tfjsonpath.New("shared_tickets"),
+							knownvalue.Bool(true),
+						),
+						statecheck.ExpectKnownValue(
+							dummyOrganizationResourceName,
+							tfjsonpath.New("notes"),
+							knownvalue.StringExact("Managed by Terraform"),
+						),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestOrganizationResource_ReadNotFound(t *testing.T) {
+	r := &OrganizationResource{client: zendeskapi.NewClient(testZendeskClient(t, testNotFoundHandler))}
+
+	state := testResourceState(t, OrganizationSchema, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.Number, 123),
+	})
+	response := &fwresource.ReadResponse{State: state}
+
+	r.Read(t.Context(), fwresource.ReadRequest{State: state}, response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", response.Diagnostics)
+	}
+
+	if !response.State.Raw.IsNull() {
+		t.Fatalf("expected resource to be removed from state, got %s", response.State.Raw)
+	}
+}
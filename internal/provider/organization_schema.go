@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var OrganizationSchema = schema.Schema{
+	Version: 0,
+	MarkdownDescription: "Organizations group end users, ex: the employees of a customer, " +
+		"see [Documentation](https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/) " +
+		"for more information on configuration",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "A unique name for the organization",
+		},
+		"external_id": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "A unique external id to associate organizations to an external record",
+			Default:     stringdefault.StaticString(""),
+		},
+		"details": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Any details about the organization, such as the address",
+			Default:     stringdefault.StaticString(""),
+		},
+		"notes": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Any notes you have about the organization",
+			Default:     stringdefault.StaticString(""),
+		},
+		"domain_names": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Description: "Domain names of the organization, users signing up with an email address of these domains are added to the organization",
+			Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+		},
+		"group_id": schema.Int64Attribute{
+			Optional:    true,
+			Description: "New tickets from users in this organization are automatically put in this group",
+		},
+		"shared_tickets": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "End users in this organization are able to see each other's tickets. Default value for provider is false",
+			Default:     booldefault.StaticBool(false),
+		},
+		"shared_comments": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "End users in this organization are able to comment on each other's tickets. Default value for provider is false",
+			Default:     booldefault.StaticBool(false),
+		},
+		"tags": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Description: "The tags of the organization. Zendesk stores tags in lowercase, with spaces replaced by underscores",
+			Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+		},
+		"organization_fields": schema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Description: "Values of the organization fields keyed by field key, ex: { tier = \"gold\" }. " +
+				"Checkbox values are \"true\" or \"false\" and date values are formatted as YYYY-MM-DD. " +
+				"Only the fields set here are managed, removing a field from the map leaves its value unchanged in Zendesk",
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The time the organization was created.",
+			Computed:    true,
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The time of the last update of the organization.",
+			Computed:    true,
+		},
+		"url": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Description: "The URL for this resource",
+		},
+	},
+}
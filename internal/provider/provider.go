@@ -5,16 +5,25 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/JacobPotter/go-zendesk/credentialtypes"
 	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/transport"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -22,6 +31,18 @@ import (
 // Ensure ScaffoldingProvider satisfies various provider interfaces.
 var _ provider.Provider = &ZendeskProvider{}
 
+// oauthPaths are the provider attributes used for OAuth authentication, which
+// cannot be combined with username and api_token.
+var oauthPaths = []path.Expression{
+	path.MatchRoot("oauth_token"),
+	path.MatchRoot("oauth_token_file"),
+}
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryMaxWait = 60
+)
+
 // ZendeskProvider defines the provider implementation.
 type ZendeskProvider struct {
 	// version is set to the provider version on release, "dev" when the
@@ -32,9 +53,16 @@ type ZendeskProvider struct {
 
 // ZendeskProviderModel describes the provider data model.
 type ZendeskProviderModel struct {
-	Subdomain types.String `tfsdk:"subdomain"`
-	Username  types.String `tfsdk:"username"`
-	APIToken  types.String `tfsdk:"api_token"`
+	Subdomain             types.String `tfsdk:"subdomain"`
+	Endpoint              types.String `tfsdk:"endpoint"`
+	Username              types.String `tfsdk:"username"`
+	APIToken              types.String `tfsdk:"api_token"`
+	OAuthToken            types.String `tfsdk:"oauth_token"`
+	OAuthTokenFile        types.String `tfsdk:"oauth_token_file"`
+	MaxRetries            types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait          types.Int64  `tfsdk:"retry_max_wait"`
+	RequestsPerMinute     types.Int64  `tfsdk:"requests_per_minute"`
+	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
 }
 
 func (p *ZendeskProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -50,14 +78,71 @@ func (p *ZendeskProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Description: "URI for Zendesk API. May also be provided via ZENDESK_SUBDOMAIN environment variable.",
 				Optional:    true,
 			},
+			"endpoint": schema.StringAttribute{
+				Description: "Base URL for Zendesk API, overriding https://<subdomain>.zendesk.com. Useful for proxies, " +
+					"sandboxes and local test servers. When set, subdomain is not required. " +
+					"May also be provided via ZENDESK_ENDPOINT environment variable.",
+				Optional: true,
+			},
 			"username": schema.StringAttribute{
 				Description: "Username for Zendesk API. May also be provided via ZENDESK_USERNAME environment variable.",
 				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(oauthPaths...),
+				},
 			},
 			"api_token": schema.StringAttribute{
 				Description: "APIToken for Zendesk API. May also be provided via ZENDESK_PASSWORD environment variable.",
 				Optional:    true,
 				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(oauthPaths...),
+				},
+			},
+			"oauth_token": schema.StringAttribute{
+				Description: "OAuth access token for Zendesk API, used as a bearer token instead of username and api_token. " +
+					"May also be provided via ZENDESK_OAUTH_TOKEN environment variable.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("oauth_token_file")),
+				},
+			},
+			"oauth_token_file": schema.StringAttribute{
+				Description: "Path to a file containing the OAuth access token for Zendesk API. " +
+					"May also be provided via ZENDESK_OAUTH_TOKEN_FILE environment variable.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of retries for rate limited requests and idempotent requests failing with a server error. "+
+					"Defaults to %d.", defaultMaxRetries),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of seconds to wait between retries, capping both Retry-After and the exponential backoff. "+
+					"Defaults to %d.", defaultRetryMaxWait),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_minute": schema.Int64Attribute{
+				Description: "Maximum number of requests per minute sent by the provider, shared by all resources and data sources. " +
+					"Use it to keep part of the account rate limit for other integrations. Unlimited by default.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of requests in flight at once, shared by all resources and data sources. Unlimited by default.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
 			},
 		},
 	}
@@ -84,6 +169,15 @@ func (p *ZendeskProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.Endpoint.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Unknown Zendesk API Endpoint",
+			"The provider cannot create the Zendesk API client as there is an unknown configuration value for the Zendesk API endpoint. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the ZENDESK_ENDPOINT environment variable.",
+		)
+	}
+
 	if config.Username.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("username"),
@@ -102,6 +196,24 @@ func (p *ZendeskProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.OAuthToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oauth_token"),
+			"Unknown Zendesk API OAuth Token",
+			"The provider cannot create the Zendesk API client as there is an unknown configuration value for the Zendesk API oauth_token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the ZENDESK_OAUTH_TOKEN environment variable.",
+		)
+	}
+
+	if config.OAuthTokenFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oauth_token_file"),
+			"Unknown Zendesk API OAuth Token File",
+			"The provider cannot create the Zendesk API client as there is an unknown configuration value for the Zendesk API oauth_token_file. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the ZENDESK_OAUTH_TOKEN_FILE environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -110,13 +222,20 @@ func (p *ZendeskProvider) Configure(ctx context.Context, req provider.ConfigureR
 	// with Terraform configuration value if set.
 
 	subdomain := os.Getenv("ZENDESK_SUBDOMAIN")
+	endpoint := os.Getenv("ZENDESK_ENDPOINT")
 	username := os.Getenv("ZENDESK_USERNAME")
 	apiToken := os.Getenv("ZENDESK_API_TOKEN")
+	oauthToken := os.Getenv("ZENDESK_OAUTH_TOKEN")
+	oauthTokenFile := os.Getenv("ZENDESK_OAUTH_TOKEN_FILE")
 
 	if !config.Subdomain.IsNull() {
 		subdomain = config.Subdomain.ValueString()
 	}
 
+	if !config.Endpoint.IsNull() {
+		endpoint = config.Endpoint.ValueString()
+	}
+
 	if !config.Username.IsNull() {
 		username = config.Username.ValueString()
 	}
@@ -125,38 +244,86 @@ func (p *ZendeskProvider) Configure(ctx context.Context, req provider.ConfigureR
 		apiToken = config.APIToken.ValueString()
 	}
 
+	if !config.OAuthToken.IsNull() {
+		oauthToken = config.OAuthToken.ValueString()
+	}
+
+	if !config.OAuthTokenFile.IsNull() {
+		oauthTokenFile = config.OAuthTokenFile.ValueString()
+	}
+
+	if oauthToken != "" && oauthTokenFile != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oauth_token"),
+			"Conflicting Zendesk API OAuth Token",
+			"The provider cannot create the Zendesk API client as both an OAuth token and an OAuth token file were provided. "+
+				"Set only one of oauth_token (ZENDESK_OAUTH_TOKEN) or oauth_token_file (ZENDESK_OAUTH_TOKEN_FILE).",
+		)
+		return
+	}
+
+	if oauthTokenFile != "" {
+		token, err := readOAuthTokenFile(oauthTokenFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("oauth_token_file"),
+				"Unable to Read Zendesk API OAuth Token File",
+				"The provider cannot create the Zendesk API client as the OAuth token file could not be read.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+		oauthToken = token
+	}
+
+	useOAuth := oauthToken != ""
+
+	if useOAuth && (username != "" || apiToken != "") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oauth_token"),
+			"Conflicting Zendesk API Credentials",
+			"The provider cannot create the Zendesk API client as both OAuth and API token credentials were provided. "+
+				"Either set oauth_token or oauth_token_file, or set username and api_token, but not both. "+
+				"Check the ZENDESK_OAUTH_TOKEN, ZENDESK_OAUTH_TOKEN_FILE, ZENDESK_USERNAME and ZENDESK_API_TOKEN environment variables as well.",
+		)
+		return
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
 	emptyValueMessage := "If either is already set, ensure the value is not empty."
 
-	if subdomain == "" {
+	if subdomain == "" && endpoint == "" {
 
 		resp.Diagnostics.AddAttributeError(
 			path.Root("subdomain"),
 			"Missing Zendesk API Host",
 			"The provider cannot create the Zendesk API client as there is a missing or empty value for the Zendesk API subdomain. "+
-				"Set the subdomain value in the configuration or use the ZENDESK_SUBDOMAIN environment variable. "+
+				"Set the subdomain value in the configuration or use the ZENDESK_SUBDOMAIN environment variable, "+
+				"or set endpoint instead. "+
 				emptyValueMessage,
 		)
 	}
 
-	if username == "" {
+	if username == "" && !useOAuth {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("username"),
 			"Missing Zendesk API Username",
 			"The provider cannot create the Zendesk API client as there is a missing or empty value for the Zendesk API username. "+
-				"Set the username value in the configuration or use the ZENDESK_USERNAME environment variable. "+
+				"Set the username value in the configuration or use the ZENDESK_USERNAME environment variable, "+
+				"or authenticate with oauth_token instead. "+
 				emptyValueMessage,
 		)
 	}
 
-	if apiToken == "" {
+	if apiToken == "" && !useOAuth {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_token"),
 			"Missing Zendesk API APIToken",
 			"The provider cannot create the Zendesk API client as there is a missing or empty value for the Zendesk API api_token. "+
-				"Set the api_token value in the configuration or use the ZENDESK_API_TOKEN environment variable. "+
+				"Set the api_token value in the configuration or use the ZENDESK_API_TOKEN environment variable, "+
+				"or authenticate with oauth_token instead. "+
 				emptyValueMessage,
 		)
 	}
@@ -165,16 +332,40 @@ func (p *ZendeskProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	maxRetries := int64(defaultMaxRetries)
+	retryMaxWait := int64(defaultRetryMaxWait)
+
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		maxRetries = config.MaxRetries.ValueInt64()
+	}
+
+	if !config.RetryMaxWait.IsNull() && !config.RetryMaxWait.IsUnknown() {
+		retryMaxWait = config.RetryMaxWait.ValueInt64()
+	}
+
+	requestsPerMinute := config.RequestsPerMinute.ValueInt64()
+	maxConcurrentRequests := config.MaxConcurrentRequests.ValueInt64()
+
 	ctx = tflog.SetField(ctx, "zendesk_subdomain", subdomain)
+	ctx = tflog.SetField(ctx, "zendesk_endpoint", endpoint)
 	ctx = tflog.SetField(ctx, "zendesk_username", username)
 	ctx = tflog.SetField(ctx, "zendesk_api_token", apiToken)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "zendesk_api_token")
+	ctx = tflog.SetField(ctx, "zendesk_oauth_token", oauthToken)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "zendesk_api_token", "zendesk_oauth_token")
 
 	tflog.Info(ctx, "Creating Zendesk client")
 
+	// Every request attempt, retries included, goes through the shared throttle and is
+	// logged when TF_LOG_PROVIDER_ZENDESK_HTTP is set.
+	wireLogger := transport.NewLoggingTransport(http.DefaultTransport, apiToken, oauthToken)
+	throttle := transport.NewThrottleTransport(wireLogger, int(requestsPerMinute), int(maxConcurrentRequests))
+
+	httpClient := &http.Client{
+		Transport: transport.NewRetryTransport(throttle, int(maxRetries), time.Duration(retryMaxWait)*time.Second),
+	}
+
 	// Create a new Zendesk client using the configuration values
-	// client, err := client.NewClient(&subdomain, &username, &apiToken)
-	client, err := zendesk.NewClient(nil)
+	client, err := zendesk.NewClient(httpClient)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -186,7 +377,23 @@ func (p *ZendeskProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	err = client.SetSubdomain(subdomain)
+	if endpoint != "" {
+		var endpointURL string
+		endpointURL, err = apiEndpointURL(endpoint)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("endpoint"),
+				"Invalid Zendesk API Endpoint",
+				"The provider cannot create the Zendesk API client as the Zendesk API endpoint is not a valid URL. "+
+					"The endpoint must be an absolute http or https URL, ex: https://proxy.example.com.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+		err = client.SetEndpointURL(endpointURL)
+	} else {
+		err = client.SetSubdomain(subdomain)
+	}
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -198,7 +405,11 @@ func (p *ZendeskProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	client.SetCredential(credentialtypes.NewAPITokenCredential(username, apiToken))
+	if useOAuth {
+		client.SetCredential(credentialtypes.NewBearerTokenCredential(oauthToken))
+	} else {
+		client.SetCredential(credentialtypes.NewAPITokenCredential(username, apiToken))
+	}
 
 	// Make the Zendesk client available during DataSource and Resource
 	// type Configure methods.
@@ -213,19 +424,37 @@ func (p *ZendeskProvider) Resources(ctx context.Context) []func() resource.Resou
 	return []func() resource.Resource{
 		NewTicketFieldResource,
 		NewMacroResource,
+		NewMacroOrderResource,
 		NewTriggerCategoryResource,
 		NewTriggerResource,
+		NewTriggerOrderResource,
 		NewAutomationResource,
 		NewViewResource,
+		NewViewOrderResource,
 		NewWebhookResource,
 		NewSLAResource,
 		NewTicketFormResource,
+		NewTicketFormOrderResource,
 		NewGroupResource,
 		NewBrandResource,
+		NewSupportAddressResource,
 		NewUserFieldResource,
 		NewOrganizationFieldResource,
+		NewCustomObjectResource,
+		NewCustomObjectFieldResource,
+		NewCustomRoleResource,
+		NewCustomStatusResource,
+		NewOrganizationResource,
+		NewUserResource,
+		NewGroupMembershipResource,
+		NewGroupMembershipsResource,
 		NewScheduleResource,
+		NewScheduleHolidayResource,
 		NewDynamicContentResource,
+		NewRoutingAttributeResource,
+		NewRoutingAttributeValueResource,
+		NewRoutingQueueResource,
+		NewRoutingQueueOrderResource,
 	}
 }
 
@@ -236,6 +465,48 @@ func (p *ZendeskProvider) DataSources(ctx context.Context) []func() datasource.D
 	}
 }
 
+// apiEndpointURL returns the Zendesk API v2 URL for the given base endpoint,
+// ex: https://proxy.example.com becomes https://proxy.example.com/api/v2.
+func apiEndpointURL(endpoint string) (string, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	if endpointURL.Scheme != "http" && endpointURL.Scheme != "https" {
+		return "", fmt.Errorf("unsupported scheme %q", endpointURL.Scheme)
+	}
+
+	if endpointURL.Host == "" {
+		return "", fmt.Errorf("missing host in %q", endpoint)
+	}
+
+	endpointURL.Path = strings.TrimSuffix(endpointURL.Path, "/")
+
+	if !strings.HasSuffix(endpointURL.Path, "/api/v2") {
+		endpointURL.Path += "/api/v2"
+	}
+
+	return endpointURL.String(), nil
+}
+
+// readOAuthTokenFile returns the trimmed contents of the OAuth token file at
+// filePath, failing if the file is empty.
+func readOAuthTokenFile(filePath string) (string, error) {
+	contents, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", err
+	}
+
+	token := strings.TrimSpace(string(contents))
+
+	if token == "" {
+		return "", fmt.Errorf("OAuth token file %s is empty", filePath)
+	}
+
+	return token, nil
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &ZendeskProvider{
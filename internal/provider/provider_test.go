@@ -5,12 +5,20 @@ package provider
 
 import (
 	"github.com/JacobPotter/go-zendesk/credentialtypes"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
 
 	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -30,12 +38,16 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("ZENDESK_SUBDOMAIN must be set for acceptance tests")
 	}
 
+	if v := os.Getenv("ZENDESK_OAUTH_TOKEN"); v != "" {
+		return
+	}
+
 	if v := os.Getenv("ZENDESK_USERNAME"); v == "" {
-		t.Fatal("ZENDESK_USERNAME must be set for acceptance tests")
+		t.Fatal("ZENDESK_USERNAME or ZENDESK_OAUTH_TOKEN must be set for acceptance tests")
 	}
 
 	if v := os.Getenv("ZENDESK_API_TOKEN"); v == "" {
-		t.Fatal("ZENDESK_API_TOKEN must be set for acceptance tests")
+		t.Fatal("ZENDESK_API_TOKEN or ZENDESK_OAUTH_TOKEN must be set for acceptance tests")
 	}
 
 }
@@ -44,6 +56,7 @@ func getZdTestClient() (*zendesk.Client, error) {
 	var subdomain = os.Getenv("ZENDESK_SUBDOMAIN")
 	var username = os.Getenv("ZENDESK_USERNAME")
 	var apiToken = os.Getenv("ZENDESK_API_TOKEN")
+	var oauthToken = os.Getenv("ZENDESK_OAUTH_TOKEN")
 
 	client, err := zendesk.NewClient(nil)
 	if err != nil {
@@ -53,8 +66,359 @@ func getZdTestClient() (*zendesk.Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	client.SetCredential(credentialtypes.NewAPITokenCredential(username, apiToken))
+	if oauthToken != "" {
+		client.SetCredential(credentialtypes.NewBearerTokenCredential(oauthToken))
+	} else {
+		client.SetCredential(credentialtypes.NewAPITokenCredential(username, apiToken))
+	}
 
 	return client, nil
 
 }
+
+// testProviderConfig builds a provider configuration from the given attribute
+// values, leaving every other attribute null.
+func testProviderConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	schemaResp := &provider.SchemaResponse{}
+	New("test")().Schema(t.Context(), provider.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+	for name, attrType := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+			continue
+		}
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, attrs),
+	}
+}
+
+// testConfigureProvider configures a new provider with only the given
+// environment variables and configuration values set.
+func testConfigureProvider(t *testing.T, env map[string]string, config map[string]tftypes.Value) *provider.ConfigureResponse {
+	t.Helper()
+
+	for _, key := range []string{
+		"ZENDESK_SUBDOMAIN",
+		"ZENDESK_ENDPOINT",
+		"ZENDESK_USERNAME",
+		"ZENDESK_API_TOKEN",
+		"ZENDESK_OAUTH_TOKEN",
+		"ZENDESK_OAUTH_TOKEN_FILE",
+	} {
+		t.Setenv(key, env[key])
+	}
+
+	resp := &provider.ConfigureResponse{}
+	New("test")().Configure(t.Context(), provider.ConfigureRequest{Config: testProviderConfig(t, config)}, resp)
+
+	return resp
+}
+
+// testZendeskClient returns a client configured by the provider to send its requests
+// to handler.
+func testZendeskClient(t *testing.T, handler http.Handler) *zendesk.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	resp := testConfigureProvider(t, nil, map[string]tftypes.Value{
+		"endpoint":    tftypes.NewValue(tftypes.String, server.URL),
+		"oauth_token": tftypes.NewValue(tftypes.String, "oauth-token"),
+		"max_retries": tftypes.NewValue(tftypes.Number, 0),
+	})
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+
+	return resp.ResourceData.(*zendesk.Client)
+}
+
+// testResourceState builds a resource state from the given attribute values, leaving
+// every other attribute null.
+func testResourceState(t *testing.T, resourceSchema rschema.Schema, values map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+
+	objectType := resourceSchema.Type().TerraformType(t.Context()).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+	for name, attrType := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+			continue
+		}
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	return tfsdk.State{
+		Schema: resourceSchema,
+		Raw:    tftypes.NewValue(objectType, attrs),
+	}
+}
+
+// testNotFoundHandler responds to every request with a Zendesk 404.
+var testNotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	_, _ = w.Write([]byte(`{"error":"RecordNotFound","description":"Not found"}`))
+})
+
+func TestZendeskProvider_Configure(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("file-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	emptyTokenFile := filepath.Join(t.TempDir(), "empty")
+	if err := os.WriteFile(emptyTokenFile, []byte("\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		testName       string
+		env            map[string]string
+		config         map[string]tftypes.Value
+		expectedError  string
+		expectedBearer bool
+		expectedSecret string
+	}{
+		{
+			testName: "should configure api token credentials",
+			config: map[string]tftypes.Value{
+				"subdomain": tftypes.NewValue(tftypes.String, "example"),
+				"username":  tftypes.NewValue(tftypes.String, "user@example.com"),
+				"api_token": tftypes.NewValue(tftypes.String, "api-token"),
+			},
+			expectedSecret: "api-token",
+		},
+		{
+			testName: "should configure oauth token credentials",
+			config: map[string]tftypes.Value{
+				"subdomain":   tftypes.NewValue(tftypes.String, "example"),
+				"oauth_token": tftypes.NewValue(tftypes.String, "oauth-token"),
+			},
+			expectedBearer: true,
+			expectedSecret: "oauth-token",
+		},
+		{
+			testName: "should configure oauth token from env",
+			env: map[string]string{
+				"ZENDESK_OAUTH_TOKEN": "env-token",
+			},
+			config: map[string]tftypes.Value{
+				"subdomain": tftypes.NewValue(tftypes.String, "example"),
+			},
+			expectedBearer: true,
+			expectedSecret: "env-token",
+		},
+		{
+			testName: "should read oauth token from file",
+			config: map[string]tftypes.Value{
+				"subdomain":        tftypes.NewValue(tftypes.String, "example"),
+				"oauth_token_file": tftypes.NewValue(tftypes.String, tokenFile),
+			},
+			expectedBearer: true,
+			expectedSecret: "file-token",
+		},
+		{
+			testName: "should fail on empty oauth token file",
+			config: map[string]tftypes.Value{
+				"subdomain":        tftypes.NewValue(tftypes.String, "example"),
+				"oauth_token_file": tftypes.NewValue(tftypes.String, emptyTokenFile),
+			},
+			expectedError: "Unable to Read Zendesk API OAuth Token File",
+		},
+		{
+			testName: "should fail on missing oauth token file",
+			config: map[string]tftypes.Value{
+				"subdomain":        tftypes.NewValue(tftypes.String, "example"),
+				"oauth_token_file": tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "missing")),
+			},
+			expectedError: "Unable to Read Zendesk API OAuth Token File",
+		},
+		{
+			testName: "should fail when mixing oauth token with env api token credentials",
+			env: map[string]string{
+				"ZENDESK_USERNAME":  "user@example.com",
+				"ZENDESK_API_TOKEN": "api-token",
+			},
+			config: map[string]tftypes.Value{
+				"subdomain":   tftypes.NewValue(tftypes.String, "example"),
+				"oauth_token": tftypes.NewValue(tftypes.String, "oauth-token"),
+			},
+			expectedError: "Conflicting Zendesk API Credentials",
+		},
+		{
+			testName: "should fail when oauth token and token file are both set",
+			env: map[string]string{
+				"ZENDESK_OAUTH_TOKEN_FILE": tokenFile,
+			},
+			config: map[string]tftypes.Value{
+				"subdomain":   tftypes.NewValue(tftypes.String, "example"),
+				"oauth_token": tftypes.NewValue(tftypes.String, "oauth-token"),
+			},
+			expectedError: "Conflicting Zendesk API OAuth Token",
+		},
+		{
+			testName: "should configure endpoint without subdomain",
+			config: map[string]tftypes.Value{
+				"endpoint":    tftypes.NewValue(tftypes.String, "http://localhost:8080"),
+				"oauth_token": tftypes.NewValue(tftypes.String, "oauth-token"),
+			},
+			expectedBearer: true,
+			expectedSecret: "oauth-token",
+		},
+		{
+			testName: "should configure request throttling",
+			config: map[string]tftypes.Value{
+				"subdomain":               tftypes.NewValue(tftypes.String, "example"),
+				"oauth_token":             tftypes.NewValue(tftypes.String, "oauth-token"),
+				"requests_per_minute":     tftypes.NewValue(tftypes.Number, 200),
+				"max_concurrent_requests": tftypes.NewValue(tftypes.Number, 4),
+			},
+			expectedBearer: true,
+			expectedSecret: "oauth-token",
+		},
+		{
+			testName: "should fail on invalid endpoint",
+			config: map[string]tftypes.Value{
+				"endpoint":    tftypes.NewValue(tftypes.String, "localhost:8080"),
+				"oauth_token": tftypes.NewValue(tftypes.String, "oauth-token"),
+			},
+			expectedError: "Invalid Zendesk API Endpoint",
+		},
+		{
+			testName: "should fail without subdomain or endpoint",
+			config: map[string]tftypes.Value{
+				"oauth_token": tftypes.NewValue(tftypes.String, "oauth-token"),
+			},
+			expectedError: "Missing Zendesk API Host",
+		},
+		{
+			testName: "should fail without credentials",
+			config: map[string]tftypes.Value{
+				"subdomain": tftypes.NewValue(tftypes.String, "example"),
+			},
+			expectedError: "Missing Zendesk API Username",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			resp := testConfigureProvider(t, c.env, c.config)
+
+			if c.expectedError != "" {
+				if !resp.Diagnostics.HasError() {
+					t.Fatalf("expected error %q, got none", c.expectedError)
+				}
+				if summary := resp.Diagnostics.Errors()[0].Summary(); summary != c.expectedError {
+					t.Fatalf("expected error %q, got %q", c.expectedError, summary)
+				}
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+			}
+
+			client, ok := resp.ResourceData.(*zendesk.Client)
+			if !ok {
+				t.Fatalf("expected *zendesk.Client, got %T", resp.ResourceData)
+			}
+
+			if client.Credential.Bearer() != c.expectedBearer {
+				t.Fatalf("expected bearer %t, got %t", c.expectedBearer, client.Credential.Bearer())
+			}
+
+			if client.Credential.Secret() != c.expectedSecret {
+				t.Fatalf("expected secret %q, got %q", c.expectedSecret, client.Credential.Secret())
+			}
+		})
+	}
+}
+
+func TestZendeskProvider_ConfigureEndpoint(t *testing.T) {
+	var gotPath, gotAuth string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"group":{"id":123,"name":"test"}}`))
+	}))
+	defer server.Close()
+
+	resp := testConfigureProvider(t, nil, map[string]tftypes.Value{
+		"endpoint":    tftypes.NewValue(tftypes.String, server.URL+"/"),
+		"oauth_token": tftypes.NewValue(tftypes.String, "oauth-token"),
+	})
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+
+	client := resp.ResourceData.(*zendesk.Client)
+
+	group, err := client.GetGroup(t.Context(), 123)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if group.Name != "test" {
+		t.Fatalf("expected group name %q, got %q", "test", group.Name)
+	}
+
+	if gotPath != "/api/v2/groups/123.json" {
+		t.Fatalf("expected path %q, got %q", "/api/v2/groups/123.json", gotPath)
+	}
+
+	if gotAuth != "Bearer oauth-token" {
+		t.Fatalf("expected authorization %q, got %q", "Bearer oauth-token", gotAuth)
+	}
+}
+
+func TestZendeskProvider_ConfigureRetries(t *testing.T) {
+	var attempts atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"group":{"id":123,"name":"test"}}`))
+	}))
+	defer server.Close()
+
+	resp := testConfigureProvider(t, nil, map[string]tftypes.Value{
+		"endpoint":       tftypes.NewValue(tftypes.String, server.URL),
+		"oauth_token":    tftypes.NewValue(tftypes.String, "oauth-token"),
+		"max_retries":    tftypes.NewValue(tftypes.Number, 1),
+		"retry_max_wait": tftypes.NewValue(tftypes.Number, 1),
+	})
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+
+	client := resp.ResourceData.(*zendesk.Client)
+
+	if _, err := client.GetGroup(t.Context(), 123); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := attempts.Load(); got != 2 {
+		t.Fatalf("expected 2 attempts, got %d", got)
+	}
+}
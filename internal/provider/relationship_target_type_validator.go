@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const customObjectTargetTypePrefix = "zen:custom_object:"
+
+// customObjectKeyRegexp matches the keys Zendesk accepts for custom objects and their fields.
+var customObjectKeyRegexp = regexp.MustCompile(`^[A-Za-z0-9_]*[A-Za-z_][A-Za-z0-9_]*$`)
+
+var _ validator.String = RelationshipTargetTypeValidator{}
+
+// RelationshipTargetTypeValidator checks the target of lookup relationship fields is a user,
+// organization, ticket or custom object, ex: zen:custom_object:asset. Values only known at
+// apply time, ex: interpolating the key of a zendesk_custom_object, are checked by Zendesk.
+type RelationshipTargetTypeValidator struct{}
+
+func (r RelationshipTargetTypeValidator) Description(_ context.Context) string {
+	return "Relationship target type must be zen:user, zen:organization, zen:ticket or zen:custom_object:<key>"
+}
+
+func (r RelationshipTargetTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return r.Description(ctx)
+}
+
+func (r RelationshipTargetTypeValidator) ValidateString(_ context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	targetType := request.ConfigValue.ValueString()
+
+	switch targetType {
+	case "zen:user", "zen:organization", "zen:ticket":
+		return
+	}
+
+	if key, ok := strings.CutPrefix(targetType, customObjectTargetTypePrefix); ok {
+		if customObjectKeyRegexp.MatchString(key) {
+			return
+		}
+
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid relationship target type",
+			fmt.Sprintf("%q must end with a custom object key made of letters, numbers and underscores, ex: %sasset", targetType, customObjectTargetTypePrefix),
+		)
+		return
+	}
+
+	if _, object, ok := strings.Cut(targetType, ":"); ok && !strings.HasPrefix(targetType, "zen:") {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid relationship target type",
+			fmt.Sprintf("%q must use the zen: prefix, ex: zen:%s", targetType, object),
+		)
+		return
+	}
+
+	response.Diagnostics.AddAttributeError(
+		request.Path,
+		"Invalid relationship target type",
+		fmt.Sprintf("%q is not a relationship target type, use zen:user, zen:organization, zen:ticket or %s<key>", targetType, customObjectTargetTypePrefix),
+	)
+}
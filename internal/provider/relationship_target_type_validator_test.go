@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRelationshipTargetTypeValidator(t *testing.T) {
+	cases := []struct {
+		testName    string
+		targetType  types.String
+		expectError bool
+	}{
+		{testName: "should accept users", targetType: types.StringValue("zen:user")},
+		{testName: "should accept tickets", targetType: types.StringValue("zen:ticket")},
+		{testName: "should accept custom objects", targetType: types.StringValue("zen:custom_object:asset")},
+		{testName: "should skip targets known at apply time", targetType: types.StringUnknown()},
+		{testName: "should reject custom objects without a key", targetType: types.StringValue("zen:custom_object:"), expectError: true},
+		{testName: "should reject invalid custom object keys", targetType: types.StringValue("zen:custom_object:hardware-asset"), expectError: true},
+		{testName: "should reject other prefixes", targetType: types.StringValue("zendesk:custom_object:asset"), expectError: true},
+		{testName: "should reject unknown targets", targetType: types.StringValue("zen:group"), expectError: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			request := validator.StringRequest{Path: path.Root("relationship_target_type"), ConfigValue: c.targetType}
+			response := &validator.StringResponse{}
+
+			RelationshipTargetTypeValidator{}.ValidateString(t.Context(), request, response)
+
+			if response.Diagnostics.HasError() != c.expectError {
+				t.Fatalf("expected error %t, got diagnostics: %+v", c.expectError, response.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.Set = RestrictedBrandsValidator{}
+
+// RestrictedBrandsValidator rejects brand restrictions on forms set to be in all brands, Zendesk
+// would silently drop one of them.
+type RestrictedBrandsValidator struct{}
+
+func (v RestrictedBrandsValidator) Description(_ context.Context) string {
+	return "restricted_brand_ids can't be set when in_all_brands is true"
+}
+
+func (v RestrictedBrandsValidator) MarkdownDescription(_ context.Context) string {
+	return "`restricted_brand_ids` can't be set when `in_all_brands` is true"
+}
+
+func (v RestrictedBrandsValidator) ValidateSet(ctx context.Context, request validator.SetRequest, response *validator.SetResponse) {
+	if request.ConfigValue.IsNull() {
+		return
+	}
+
+	var inAllBrands types.Bool
+
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("in_all_brands"), &inAllBrands)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if inAllBrands.ValueBool() {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Conflicting brand settings",
+			"restricted_brand_ids can't be set on a form in all brands, remove in_all_brands or set it to false",
+		)
+	}
+}
+
+var _ validator.Bool = InAllBrandsValidator{}
+
+// InAllBrandsValidator rejects forms set to not be in all brands without brand restrictions,
+// Zendesk would keep them in all brands.
+type InAllBrandsValidator struct{}
+
+func (v InAllBrandsValidator) Description(_ context.Context) string {
+	return "in_all_brands can only be false when restricted_brand_ids is set"
+}
+
+func (v InAllBrandsValidator) MarkdownDescription(_ context.Context) string {
+	return "`in_all_brands` can only be false when `restricted_brand_ids` is set"
+}
+
+func (v InAllBrandsValidator) ValidateBool(ctx context.Context, request validator.BoolRequest, response *validator.BoolResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() || request.ConfigValue.ValueBool() {
+		return
+	}
+
+	var brandIDs types.Set
+
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("restricted_brand_ids"), &brandIDs)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if brandIDs.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Conflicting brand settings",
+			"in_all_brands can only be false on a form restricted to brands, set restricted_brand_ids or remove in_all_brands",
+		)
+	}
+}
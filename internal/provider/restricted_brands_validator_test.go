@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRestrictedBrandsValidator(t *testing.T) {
+	brandIDs := types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1)})
+
+	cases := []struct {
+		testName    string
+		inAllBrands tftypes.Value
+		brandIDs    types.Set
+		expectError bool
+	}{
+		{
+			testName:    "should accept brand restrictions alone",
+			inAllBrands: tftypes.NewValue(tftypes.Bool, nil),
+			brandIDs:    brandIDs,
+		},
+		{
+			testName:    "should accept brand restrictions on a form not in all brands",
+			inAllBrands: tftypes.NewValue(tftypes.Bool, false),
+			brandIDs:    brandIDs,
+		},
+		{
+			testName:    "should accept a form in all brands without restrictions",
+			inAllBrands: tftypes.NewValue(tftypes.Bool, true),
+			brandIDs:    types.SetNull(types.Int64Type),
+		},
+		{
+			testName:    "should reject brand restrictions on a form in all brands",
+			inAllBrands: tftypes.NewValue(tftypes.Bool, true),
+			brandIDs:    brandIDs,
+			expectError: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			state := testResourceState(t, TicketFormSchema, map[string]tftypes.Value{
+				"in_all_brands": c.inAllBrands,
+			})
+
+			request := validator.SetRequest{
+				Path:        path.Root("restricted_brand_ids"),
+				ConfigValue: c.brandIDs,
+				Config:      tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+			}
+			response := &validator.SetResponse{}
+
+			RestrictedBrandsValidator{}.ValidateSet(t.Context(), request, response)
+
+			if response.Diagnostics.HasError() != c.expectError {
+				t.Fatalf("expected error %t, got diagnostics: %+v", c.expectError, response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestInAllBrandsValidator(t *testing.T) {
+	brandIDs := tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, []tftypes.Value{tftypes.NewValue(tftypes.Number, 1)})
+
+	cases := []struct {
+		testName    string
+		inAllBrands types.Bool
+		brandIDs    tftypes.Value
+		expectError bool
+	}{
+		{
+			testName:    "should accept a form in all brands",
+			inAllBrands: types.BoolValue(true),
+			brandIDs:    tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, nil),
+		},
+		{
+			testName:    "should accept a form not in all brands restricted to brands",
+			inAllBrands: types.BoolValue(false),
+			brandIDs:    brandIDs,
+		},
+		{
+			testName:    "should accept brand restrictions not known yet",
+			inAllBrands: types.BoolValue(false),
+			brandIDs:    tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, tftypes.UnknownValue),
+		},
+		{
+			testName:    "should reject a form not in all brands without brand restrictions",
+			inAllBrands: types.BoolValue(false),
+			brandIDs:    tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, nil),
+			expectError: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			state := testResourceState(t, TicketFormSchema, map[string]tftypes.Value{
+				"restricted_brand_ids": c.brandIDs,
+			})
+
+			request := validator.BoolRequest{
+				Path:        path.Root("in_all_brands"),
+				ConfigValue: c.inAllBrands,
+				Config:      tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+			}
+			response := &validator.BoolResponse{}
+
+			InAllBrandsValidator{}.ValidateBool(t.Context(), request, response)
+
+			if response.Diagnostics.HasError() != c.expectError {
+				t.Fatalf("expected error %t, got diagnostics: %+v", c.expectError, response.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.ResourceWithImportState = &RoutingAttributeResource{}
+var _ resource.ResourceWithConfigure = &RoutingAttributeResource{}
+
+type RoutingAttributeResource struct {
+	client *zendeskapi.Client
+}
+
+func NewRoutingAttributeResource() resource.Resource {
+	return &RoutingAttributeResource{}
+}
+
+func (a *RoutingAttributeResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*zendesk.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendesk.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = zendeskapi.NewClient(client)
+}
+
+func (a *RoutingAttributeResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_routing_attribute"
+}
+
+func (a *RoutingAttributeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = RoutingAttributeSchema
+}
+
+func (a *RoutingAttributeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data models.RoutingAttributeResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newAttribute, diags := data.GetApiModelFromTfModel(ctx)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	attribute, err := a.client.CreateRoutingAttribute(ctx, newAttribute)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error creating routing attribute", err, request.Plan.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, attribute)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (a *RoutingAttributeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data models.RoutingAttributeResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	attribute, err := a.client.GetRoutingAttribute(ctx, data.ID.ValueString())
+
+	if models.IsNotFound(err) {
+		tflog.Warn(ctx, "Routing attribute not found, removing from state", map[string]any{"id": data.ID.ValueString()})
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error reading routing attribute", err, request.State.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, attribute)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (a *RoutingAttributeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data models.RoutingAttributeResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updatedAttribute, diags := data.GetApiModelFromTfModel(ctx)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	attribute, err := a.client.UpdateRoutingAttribute(ctx, data.ID.ValueString(), updatedAttribute)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error updating routing attribute", err, request.Plan.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, attribute)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (a *RoutingAttributeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data models.RoutingAttributeResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	err := a.client.DeleteRoutingAttribute(ctx, data.ID.ValueString())
+
+	if err != nil && !models.IsNotFound(err) {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error deleting routing attribute", err, request.State.Schema)...)
+		return
+	}
+}
+
+// ImportState imports a routing attribute by its ID, or by name with an ID formatted as name:<name>.
+func (a *RoutingAttributeResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	var data models.RoutingAttributeResourceModel
+
+	id := request.ID
+
+	// IDs are UUIDs, any ID with a prefix is resolved by name
+	if strings.Contains(id, ":") {
+		var diags diag.Diagnostics
+		id, diags = routingAttributeImportLookup(a.client.Client).Resolve(ctx, id)
+
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	attribute, err := a.client.GetRoutingAttribute(ctx, id)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error importing resource", err, response.State.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, attribute)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func routingAttributeImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendeskapi.RoutingAttribute] {
+	return models.ImportLookup[zendeskapi.RoutingAttribute]{
+		List: zendeskapi.NewClient(zdClient).GetRoutingAttributes,
+		ID:   func(attribute zendeskapi.RoutingAttribute) string { return attribute.ID },
+		Attributes: map[string]func(zendeskapi.RoutingAttribute) string{
+			"name": func(attribute zendeskapi.RoutingAttribute) string { return attribute.Name },
+		},
+	}
+}
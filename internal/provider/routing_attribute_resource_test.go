@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+var dummyRoutingAttributeResourceName = "zendesk_routing_attribute.test"
+
+func TestAccRoutingAttribute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("basic_routing_attribute", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyRoutingAttributeResourceName,
+							tfjsonpath.New("name"),
+							knownvalue.StringExact(fullResourceName),
+						),
+					},
+				},
+				{
+					ResourceName:            dummyRoutingAttributeResourceName,
+					ImportState:             true,
+					ImportStateId:           "name:" + fullResourceName,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"updated_at"},
+					ConfigFile:              config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+var RoutingAttributeSchema = schema.Schema{
+	Version: 0,
+	MarkdownDescription: "Routing attribute, a skill type of skills-based routing, ex: Language or Product tier. " +
+		"Its skills are declared with `zendesk_routing_attribute_value`. " +
+		"See [Skills-based routing](https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/) " +
+		"for more information.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"url": schema.StringAttribute{
+			Computed:    true,
+			Description: "The URL for this resource",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "Name of the routing attribute",
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The time the routing attribute was created.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The time of the last update of the routing attribute.",
+			Computed:    true,
+		},
+	},
+}
+
+var RoutingAttributeValueSchema = schema.Schema{
+	Version: 0,
+	MarkdownDescription: "Routing attribute value, a skill given to agents and to the tickets matching its conditions, " +
+		"ex: French for a Language attribute. " +
+		"See [Skills-based routing](https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/) " +
+		"for more information.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"attribute_id": schema.StringAttribute{
+			Required:    true,
+			Description: "ID of the routing attribute of the value. Changing it will delete the value and create a new one.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"url": schema.StringAttribute{
+			Computed:    true,
+			Description: "The URL for this resource",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "Name of the routing attribute value",
+		},
+		"conditions": getRoutingConditionsAttribute("Tickets matching the conditions are given the skill, they are the conditions of triggers"),
+		"created_at": schema.StringAttribute{
+			Description: "The time the routing attribute value was created.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The time of the last update of the routing attribute value.",
+			Computed:    true,
+		},
+	},
+}
+
+// getRoutingConditionsAttribute returns the optional conditions of routing resources, which
+// Zendesk evaluates as trigger conditions.
+func getRoutingConditionsAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"all": schema.ListNestedAttribute{
+				Description:  "Logical AND. All the conditions must be met",
+				NestedObject: GetNestedConditionObject("trigger"),
+				Optional:     true,
+			},
+			"any": schema.ListNestedAttribute{
+				Description:  "Logical OR. Any condition can be met",
+				NestedObject: GetNestedConditionObject("trigger"),
+				Optional:     true,
+			},
+		},
+	}
+}
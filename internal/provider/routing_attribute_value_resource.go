@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.ResourceWithImportState = &RoutingAttributeValueResource{}
+var _ resource.ResourceWithConfigure = &RoutingAttributeValueResource{}
+
+type RoutingAttributeValueResource struct {
+	client *zendeskapi.Client
+}
+
+func NewRoutingAttributeValueResource() resource.Resource {
+	return &RoutingAttributeValueResource{}
+}
+
+func (v *RoutingAttributeValueResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*zendesk.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendesk.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	v.client = zendeskapi.NewClient(client)
+}
+
+func (v *RoutingAttributeValueResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_routing_attribute_value"
+}
+
+func (v *RoutingAttributeValueResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = RoutingAttributeValueSchema
+}
+
+func (v *RoutingAttributeValueResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data models.RoutingAttributeValueResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newValue, diags := data.GetApiModelFromTfModel(ctx)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	value, err := v.client.CreateRoutingAttributeValue(ctx, data.AttributeID.ValueString(), newValue)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error creating routing attribute value", err, request.Plan.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, value)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (v *RoutingAttributeValueResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data models.RoutingAttributeValueResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	value, err := v.client.GetRoutingAttributeValue(ctx, data.AttributeID.ValueString(), data.ID.ValueString())
+
+	if models.IsNotFound(err) {
+		tflog.Warn(ctx, "Routing attribute value not found, removing from state", map[string]any{"id": data.ID.ValueString()})
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error reading routing attribute value", err, request.State.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, value)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (v *RoutingAttributeValueResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data models.RoutingAttributeValueResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updatedValue, diags := data.GetApiModelFromTfModel(ctx)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	value, err := v.client.UpdateRoutingAttributeValue(ctx, data.AttributeID.ValueString(), data.ID.ValueString(), updatedValue)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error updating routing attribute value", err, request.Plan.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, value)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (v *RoutingAttributeValueResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data models.RoutingAttributeValueResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	err := v.client.DeleteRoutingAttributeValue(ctx, data.AttributeID.ValueString(), data.ID.ValueString())
+
+	if err != nil && !models.IsNotFound(err) {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error deleting routing attribute value", err, request.State.Schema)...)
+		return
+	}
+}
+
+// ImportState imports a value with an ID formatted as <attribute_id>/<value_id>.
+func (v *RoutingAttributeValueResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	attributeID, valueID, err := parseRoutingAttributeValueID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("Invalid import id", err.Error())
+		return
+	}
+
+	value, err := v.client.GetRoutingAttributeValue(ctx, attributeID, valueID)
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error importing resource", err, response.State.Schema)...)
+		return
+	}
+
+	data := models.RoutingAttributeValueResourceModel{
+		AttributeID: types.StringValue(attributeID),
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, value)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// parseRoutingAttributeValueID splits an <attribute_id>/<value_id> import ID.
+func parseRoutingAttributeValueID(id string) (attributeID string, valueID string, err error) {
+	attributeID, valueID, found := strings.Cut(id, "/")
+	if !found || attributeID == "" || valueID == "" {
+		return "", "", fmt.Errorf("import id %q must be formatted as <attribute_id>/<value_id>", id)
+	}
+
+	return attributeID, valueID, nil
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+var dummyRoutingAttributeValueResourceName = "zendesk_routing_attribute_value.test"
+
+func TestAccRoutingAttributeValue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("conditions_value", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyRoutingAttributeValueResourceName,
+							tfjsonpath.New("conditions").AtMapKey("all"),
+							knownvalue.ListSizeExact(1),
+						),
+					},
+				},
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyRoutingAttributeValueResourceName,
+							tfjsonpath.New("conditions").AtMapKey("any"),
+							knownvalue.ListSizeExact(2),
+						),
+					},
+				},
+				{
+					ResourceName: dummyRoutingAttributeValueResourceName,
+					ImportState:  true,
+					ImportStateIdFunc: func(state *terraform.State) (string, error) {
+						value := state.RootModule().Resources[dummyRoutingAttributeValueResourceName].Primary
+						return value.Attributes["attribute_id"] + "/" + value.ID, nil
+					},
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"updated_at"},
+					ConfigDirectory:         config.StaticDirectory("testdata/TestAccRoutingAttributeValue/conditions_value/2"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestParseRoutingAttributeValueID(t *testing.T) {
+	cases := []struct {
+		testName    string
+		input       string
+		attributeID string
+		valueID     string
+		expectError bool
+	}{
+		{testName: "should parse attribute and value ids", input: "15821cba/b376b35a", attributeID: "15821cba", valueID: "b376b35a"},
+		{testName: "should reject a value id alone", input: "b376b35a", expectError: true},
+		{testName: "should reject a missing attribute id", input: "/b376b35a", expectError: true},
+		{testName: "should reject a missing value id", input: "15821cba/", expectError: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			attributeID, valueID, err := parseRoutingAttributeValueID(c.input)
+
+			if (err != nil) != c.expectError {
+				t.Fatalf("expected error %t, got %v", c.expectError, err)
+			}
+
+			if attributeID != c.attributeID || valueID != c.valueID {
+				t.Fatalf("expected %s/%s, got %s/%s", c.attributeID, c.valueID, attributeID, valueID)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// NewRoutingQueueOrderResource manages the order of the routing queues, there is one per account.
+func NewRoutingQueueOrderResource() resource.Resource {
+	return &orderResource[zendeskapi.RoutingQueue, string]{
+		typeName:  "_routing_queue_order",
+		id:        models.RoutingQueueOrderID,
+		attribute: "queue_ids",
+		items:     "routing queues",
+		schema:    RoutingQueueOrderSchema,
+		list: func(ctx context.Context, client *zendeskapi.Client) ([]zendeskapi.RoutingQueue, error) {
+			return client.GetRoutingQueues(ctx)
+		},
+		currentOrder: models.RoutingQueueIDsByOrder,
+		update: func(ctx context.Context, client *zendeskapi.Client, ids []string) error {
+			return client.ReorderRoutingQueues(ctx, ids)
+		},
+	}
+}
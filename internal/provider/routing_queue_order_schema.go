@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var RoutingQueueOrderSchema = schema.Schema{
+	Version: 0,
+	MarkdownDescription: "Order in which the omnichannel routing queues of the account are evaluated, set with a single reorder request. " +
+		"The listed queues come first, queues not listed keep their relative order after them. " +
+		"Destroying the resource keeps the current order. " +
+		"See [Omnichannel Routing Queues](https://developer.zendesk.com/api-reference/agent-availability/omnichannel-routing-queues/) for more information.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the routing queue order, always routing_queue_order",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"queue_ids": schema.ListAttribute{
+			Required:    true,
+			ElementType: types.StringType,
+			Description: "IDs of the routing queues in the order they are evaluated",
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+			},
+		},
+	},
+}
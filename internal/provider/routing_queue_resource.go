@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.ResourceWithImportState = &RoutingQueueResource{}
+var _ resource.ResourceWithConfigure = &RoutingQueueResource{}
+
+type RoutingQueueResource struct {
+	client *zendeskapi.Client
+}
+
+func NewRoutingQueueResource() resource.Resource {
+	return &RoutingQueueResource{}
+}
+
+func (q *RoutingQueueResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*zendesk.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendesk.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	q.client = zendeskapi.NewClient(client)
+}
+
+func (q *RoutingQueueResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_routing_queue"
+}
+
+func (q *RoutingQueueResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = RoutingQueueSchema
+}
+
+func (q *RoutingQueueResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data models.RoutingQueueResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newQueue, diags := data.GetApiModelFromTfModel(ctx)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	queue, err := q.client.CreateRoutingQueue(ctx, newQueue)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error creating routing queue", err, request.Plan.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, queue)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (q *RoutingQueueResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data models.RoutingQueueResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	queue, err := q.client.GetRoutingQueue(ctx, data.ID.ValueString())
+
+	if models.IsNotFound(err) {
+		tflog.Warn(ctx, "Routing queue not found, removing from state", map[string]any{"id": data.ID.ValueString()})
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error reading routing queue", err, request.State.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, queue)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (q *RoutingQueueResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data models.RoutingQueueResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updatedQueue, diags := data.GetApiModelFromTfModel(ctx)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	queue, err := q.client.UpdateRoutingQueue(ctx, data.ID.ValueString(), updatedQueue)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error updating routing queue", err, request.Plan.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, queue)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (q *RoutingQueueResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data models.RoutingQueueResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	err := q.client.DeleteRoutingQueue(ctx, data.ID.ValueString())
+
+	if err != nil && !models.IsNotFound(err) {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error deleting routing queue", err, request.State.Schema)...)
+		return
+	}
+}
+
+// ImportState imports a routing queue by its ID, or by name with an ID formatted as name:<name>.
+func (q *RoutingQueueResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	var data models.RoutingQueueResourceModel
+
+	id := request.ID
+
+	// IDs are ULIDs, any ID with a prefix is resolved by name
+	if strings.Contains(id, ":") {
+		var diags diag.Diagnostics
+		id, diags = routingQueueImportLookup(q.client.Client).Resolve(ctx, id)
+
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	queue, err := q.client.GetRoutingQueue(ctx, id)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error importing resource", err, response.State.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, queue)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func routingQueueImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendeskapi.RoutingQueue] {
+	return models.ImportLookup[zendeskapi.RoutingQueue]{
+		List: zendeskapi.NewClient(zdClient).GetRoutingQueues,
+		ID:   func(queue zendeskapi.RoutingQueue) string { return queue.ID },
+		Attributes: map[string]func(zendeskapi.RoutingQueue) string{
+			"name": func(queue zendeskapi.RoutingQueue) string { return queue.Name },
+		},
+	}
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+var dummyRoutingQueueResourceName = "zendesk_routing_queue.test"
+
+func TestAccRoutingQueue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("update_routing_queue", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyRoutingQueueResourceName,
+							tfjsonpath.New("primary_groups"),
+							knownvalue.SetSizeExact(1),
+						),
+						statecheck.ExpectKnownValue(
+							dummyRoutingQueueResourceName,
+							tfjsonpath.New("secondary_groups"),
+							knownvalue.SetSizeExact(0),
+						),
+					},
+				},
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyRoutingQueueResourceName,
+							tfjsonpath.New("priority"),
+							knownvalue.Int64Exact(2),
+						),
+						statecheck.ExpectKnownValue(
+							dummyRoutingQueueResourceName,
+							tfjsonpath.New("secondary_groups"),
+							knownvalue.SetSizeExact(1),
+						),
+					},
+				},
+				{
+					ResourceName:            dummyRoutingQueueResourceName,
+					ImportState:             true,
+					ImportStateId:           "name:" + fullResourceName,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"updated_at"},
+					ConfigDirectory:         config.StaticDirectory("testdata/TestAccRoutingQueue/update_routing_queue/2"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var RoutingQueueSchema = schema.Schema{
+	Version: 0,
+	MarkdownDescription: "Omnichannel routing queue. Work items matching the queue conditions are offered to the agents " +
+		"of its primary groups, then of its secondary groups. Queues are evaluated in their `order`, " +
+		"the first matching queue routes the work item, the order is set with `zendesk_routing_queue_order`. " +
+		"See [Omnichannel Routing Queues](https://developer.zendesk.com/api-reference/agent-availability/omnichannel-routing-queues/) " +
+		"for more information.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"url": schema.StringAttribute{
+			Computed:    true,
+			Description: "The URL for this resource",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "Name of the queue",
+		},
+		"description": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Description of the queue",
+			Default:     stringdefault.StaticString(""),
+		},
+		"priority": schema.Int64Attribute{
+			Required:    true,
+			Description: "Priority of the work items routed by the queue",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"order": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Position of the queue in the evaluation order, starting at 1. It is set with zendesk_routing_queue_order",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"primary_groups": schema.SetAttribute{
+			Required:    true,
+			ElementType: types.Int64Type,
+			Description: "IDs of the groups work items are offered to first",
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+		},
+		"secondary_groups": schema.SetAttribute{
+			Optional:    true,
+			Computed:    true,
+			ElementType: types.Int64Type,
+			Description: "IDs of the groups work items are offered to when no agent of the primary groups is available",
+			Default:     setdefault.StaticValue(types.SetValueMust(types.Int64Type, []attr.Value{})),
+		},
+		"conditions": getRoutingConditionsAttribute("Work items matching the conditions are routed by the queue, they are the conditions of triggers"),
+		"created_at": schema.StringAttribute{
+			Description: "The time the queue was created.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The time of the last update of the queue.",
+			Computed:    true,
+		},
+	},
+}
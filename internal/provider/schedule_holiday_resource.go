@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithImportState = &ScheduleHolidayResource{}
+var _ resource.ResourceWithConfigure = &ScheduleHolidayResource{}
+var _ resource.ResourceWithValidateConfig = &ScheduleHolidayResource{}
+
+type ScheduleHolidayResource struct {
+	client *zendeskapi.Client
+}
+
+func NewScheduleHolidayResource() resource.Resource {
+	return &ScheduleHolidayResource{}
+}
+
+func (h *ScheduleHolidayResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_schedule_holiday"
+}
+
+func (h *ScheduleHolidayResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*zendesk.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendesk.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	h.client = zendeskapi.NewClient(client)
+}
+
+func (h *ScheduleHolidayResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = ScheduleHolidaySchema
+}
+
+func (h *ScheduleHolidayResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	data := &models.ScheduleHolidayResourceModel{}
+	models.CreateResource(ctx, request, response, data, func(ctx context.Context, holiday zendeskapi.ScheduleHoliday) (zendeskapi.ScheduleHoliday, error) {
+		return h.client.CreateScheduleHoliday(ctx, data.ScheduleID.ValueInt64(), holiday)
+	})
+}
+
+func (h *ScheduleHolidayResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	data := &models.ScheduleHolidayResourceModel{}
+	models.ReadResource(ctx, request, response, data, func(ctx context.Context, id int64) (zendeskapi.ScheduleHoliday, error) {
+		return h.client.GetScheduleHoliday(ctx, data.ScheduleID.ValueInt64(), id)
+	})
+}
+
+func (h *ScheduleHolidayResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	data := &models.ScheduleHolidayResourceModel{}
+	models.UpdateResource(ctx, request, response, data, func(ctx context.Context, id int64, holiday zendeskapi.ScheduleHoliday) (zendeskapi.ScheduleHoliday, error) {
+		return h.client.UpdateScheduleHoliday(ctx, data.ScheduleID.ValueInt64(), id, holiday)
+	})
+}
+
+func (h *ScheduleHolidayResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	data := &models.ScheduleHolidayResourceModel{}
+	models.DeleteResource[zendeskapi.ScheduleHoliday](ctx, request, response, data, func(ctx context.Context, id int64) error {
+		return h.client.DeleteScheduleHoliday(ctx, data.ScheduleID.ValueInt64(), id)
+	})
+}
+
+// ImportState imports a holiday with an ID formatted as <schedule_id>/<holiday_id>.
+func (h *ScheduleHolidayResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	scheduleID, holidayID, err := parseScheduleHolidayID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("Invalid import id", err.Error())
+		return
+	}
+
+	holiday, err := h.client.GetScheduleHoliday(ctx, scheduleID, holidayID)
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error importing resource", err, ScheduleHolidaySchema)...)
+		return
+	}
+
+	data := models.ScheduleHolidayResourceModel{
+		ScheduleID: types.Int64Value(scheduleID),
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, holiday)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (h *ScheduleHolidayResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data models.ScheduleHolidayResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.StartDate.IsNull() || data.StartDate.IsUnknown() || data.EndDate.IsNull() || data.EndDate.IsUnknown() {
+		return
+	}
+
+	startDate, startErr := time.Parse(time.DateOnly, data.StartDate.ValueString())
+	endDate, endErr := time.Parse(time.DateOnly, data.EndDate.ValueString())
+
+	if startErr != nil {
+		response.Diagnostics.AddAttributeError(path.Root("start_date"), "Invalid holiday date", startErr.Error())
+	}
+
+	if endErr != nil {
+		response.Diagnostics.AddAttributeError(path.Root("end_date"), "Invalid holiday date", endErr.Error())
+	}
+
+	if startErr != nil || endErr != nil {
+		return
+	}
+
+	if endDate.Before(startDate) {
+		response.Diagnostics.AddAttributeError(
+			path.Root("end_date"),
+			"Invalid attribute combination",
+			fmt.Sprintf("end_date %s must be on or after start_date %s", data.EndDate.ValueString(), data.StartDate.ValueString()),
+		)
+	}
+}
+
+// parseScheduleHolidayID splits a <schedule_id>/<holiday_id> import ID.
+func parseScheduleHolidayID(id string) (scheduleID int64, holidayID int64, err error) {
+	scheduleIDStr, holidayIDStr, found := strings.Cut(id, "/")
+	if found {
+		scheduleID, err = strconv.ParseInt(scheduleIDStr, 10, 64)
+	}
+	if found && err == nil {
+		holidayID, err = strconv.ParseInt(holidayIDStr, 10, 64)
+	}
+	if !found || err != nil {
+		return 0, 0, fmt.Errorf("import id %q must be formatted as <schedule_id>/<holiday_id>, ex: 123/456", id)
+	}
+
+	return scheduleID, holidayID, nil
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+var dummyScheduleHolidayResourceName = "zendesk_schedule_holiday.test"
+
+func TestAccScheduleHoliday(t *testing.T) {
+	t.Parallel()
+
+	t.Run("basic holiday", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyScheduleHolidayResourceName,
+							tfjsonpath.New("start_date"),
+							knownvalue.StringExact("2030-12-25"),
+						),
+					},
+				},
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ImportState:       true,
+					ImportStateVerify: true,
+					ImportStateIdFunc: func(s *terraform.State) (string, error) {
+						rs := s.RootModule().Resources[dummyScheduleHolidayResourceName]
+						return rs.Primary.Attributes["schedule_id"] + "/" + rs.Primary.ID, nil
+					},
+					ResourceName: dummyScheduleHolidayResourceName,
+				},
+			},
+		})
+	})
+
+	t.Run("update holiday", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+				},
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyScheduleHolidayResourceName,
+							tfjsonpath.New("end_date"),
+							knownvalue.StringExact("2031-01-01"),
+						),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("end before start", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ExpectError: regexp.MustCompile(`must be on or after start_date`),
+				},
+			},
+		})
+	})
+}
+
+func TestScheduleHolidayResource_ValidateConfig(t *testing.T) {
+	cases := []struct {
+		testName    string
+		startDate   string
+		endDate     string
+		expectError bool
+	}{
+		{testName: "should accept a single day", startDate: "2030-12-25", endDate: "2030-12-25"},
+		{testName: "should accept several days", startDate: "2030-12-24", endDate: "2031-01-01"},
+		{testName: "should reject end before start", startDate: "2030-12-26", endDate: "2030-12-25", expectError: true},
+		{testName: "should reject invalid dates", startDate: "2030-02-30", endDate: "2030-03-01", expectError: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			state := testResourceState(t, ScheduleHolidaySchema, map[string]tftypes.Value{
+				"schedule_id": tftypes.NewValue(tftypes.Number, 1),
+				"name":        tftypes.NewValue(tftypes.String, "Christmas"),
+				"start_date":  tftypes.NewValue(tftypes.String, c.startDate),
+				"end_date":    tftypes.NewValue(tftypes.String, c.endDate),
+			})
+			request := fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}
+			response := &fwresource.ValidateConfigResponse{}
+
+			NewScheduleHolidayResource().(*ScheduleHolidayResource).ValidateConfig(t.Context(), request, response)
+
+			if response.Diagnostics.HasError() != c.expectError {
+				t.Fatalf("expected error %t, got diagnostics: %+v", c.expectError, response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestParseScheduleHolidayID(t *testing.T) {
+	cases := []struct {
+		testName    string
+		input       string
+		schedule    int64
+		holiday     int64
+		expectError bool
+	}{
+		{testName: "should parse schedule and holiday ids", input: "123/456", schedule: 123, holiday: 456},
+		{testName: "should reject a holiday id alone", input: "456", expectError: true},
+		{testName: "should reject non numeric ids", input: "123/christmas", expectError: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			scheduleID, holidayID, err := parseScheduleHolidayID(c.input)
+
+			if (err != nil) != c.expectError {
+				t.Fatalf("expected error %t, got %v", c.expectError, err)
+			}
+
+			if scheduleID != c.schedule || holidayID != c.holiday {
+				t.Fatalf("expected %d/%d, got %d/%d", c.schedule, c.holiday, scheduleID, holidayID)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var holidayDateValidators = []validator.String{
+	stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date formatted as YYYY-MM-DD"),
+}
+
+var ScheduleHolidaySchema = schema.Schema{
+	Version: 0,
+	MarkdownDescription: "Holiday of a business hours schedule, business hours are not counted between its start and end dates. " +
+		"See [Holidays](https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#holidays) " +
+		"for more information.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"schedule_id": schema.Int64Attribute{
+			Required:    true,
+			Description: "ID of the schedule the holiday belongs to. Changing the schedule will recreate the resource.",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "Name of the holiday",
+		},
+		"start_date": schema.StringAttribute{
+			Required:    true,
+			Description: "First day of the holiday, formatted as YYYY-MM-DD",
+			Validators:  holidayDateValidators,
+		},
+		"end_date": schema.StringAttribute{
+			Required:    true,
+			Description: "Last day of the holiday, formatted as YYYY-MM-DD. Must be on or after start_date",
+			Validators:  holidayDateValidators,
+		},
+	},
+}
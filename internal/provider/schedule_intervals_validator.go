@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ScheduleIntervalsValidator checks the intervals of a weekday end after they start, are
+// listed in chronological order and don't overlap, as Zendesk returns them sorted.
+type ScheduleIntervalsValidator struct{}
+
+var _ validator.List = ScheduleIntervalsValidator{}
+
+func (s ScheduleIntervalsValidator) Description(_ context.Context) string {
+	return "Intervals must end after they start, intervals of a day must be in chronological order and must not overlap"
+}
+
+func (s ScheduleIntervalsValidator) MarkdownDescription(ctx context.Context) string {
+	return s.Description(ctx)
+}
+
+func (s ScheduleIntervalsValidator) ValidateList(_ context.Context, request validator.ListRequest, response *validator.ListResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// times are read from the elements rather than with ElementsAs, which would report
+	// invalid times a second time along with the time of day type validation
+	type interval struct{ start, end *int }
+
+	minutes := func(value attr.Value) *int {
+		timeOfDay, ok := value.(models.TimeOfDayValue)
+		if !ok || timeOfDay.IsNull() || timeOfDay.IsUnknown() {
+			return nil
+		}
+		m, err := timeOfDay.Minutes()
+		if err != nil {
+			return nil
+		}
+		return &m
+	}
+
+	elements := request.ConfigValue.Elements()
+	parsed := make([]interval, len(elements))
+
+	for i, element := range elements {
+		object, ok := element.(types.Object)
+		if !ok || object.IsNull() || object.IsUnknown() {
+			continue
+		}
+
+		attributes := object.Attributes()
+		parsed[i] = interval{start: minutes(attributes["start_time"]), end: minutes(attributes["end_time"])}
+
+		if parsed[i].start != nil && parsed[i].end != nil && *parsed[i].end <= *parsed[i].start {
+			response.Diagnostics.AddAttributeError(
+				request.Path.AtListIndex(i).AtName("end_time"),
+				"Invalid schedule intervals",
+				fmt.Sprintf("Interval %d must end after it starts, %s is not after %s", i, attributes["end_time"], attributes["start_time"]),
+			)
+		}
+	}
+
+	for i := 1; i < len(parsed); i++ {
+		previous, current := parsed[i-1], parsed[i]
+
+		if previous.start == nil || previous.end == nil || current.start == nil {
+			continue
+		}
+
+		switch {
+		case *current.start < *previous.start:
+			response.Diagnostics.AddAttributeError(
+				request.Path.AtListIndex(i),
+				"Invalid schedule intervals",
+				fmt.Sprintf("Intervals of a day must be in chronological order, interval %d starts before interval %d", i, i-1),
+			)
+		case *current.start < *previous.end:
+			response.Diagnostics.AddAttributeError(
+				request.Path.AtListIndex(i),
+				"Invalid schedule intervals",
+				fmt.Sprintf("Intervals of a day must not overlap, interval %d starts before interval %d ends", i, i-1),
+			)
+		}
+	}
+}
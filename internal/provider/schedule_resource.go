@@ -8,6 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
+var _ resource.ResourceWithImportState = &ScheduleResource{}
+var _ resource.ResourceWithUpgradeState = &ScheduleResource{}
+
 type ScheduleResource struct {
 	client *zendesk.Client
 }
@@ -64,7 +67,7 @@ func (s *ScheduleResource) Create(ctx context.Context, request resource.CreateRe
 	scheduleResp, err := s.client.CreateSchedule(ctx, newSchedule)
 
 	if err != nil {
-		response.Diagnostics.AddError("Error while creating schedule", "Error creating schedule: "+err.Error())
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error while creating schedule", err, request.Plan.Schema)...)
 		return
 	}
 
@@ -108,7 +111,7 @@ func (s *ScheduleResource) Update(ctx context.Context, request resource.UpdateRe
 
 	scheduleResp, err := s.client.UpdateSchedule(ctx, data.ID.ValueInt64(), updatedSchedule)
 	if err != nil {
-		response.Diagnostics.AddError("Error while updating schedule", "Error updating schedule: "+err.Error())
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error while updating schedule", err, request.Plan.Schema)...)
 		return
 	}
 
@@ -133,5 +136,55 @@ func (s *ScheduleResource) Delete(ctx context.Context, request resource.DeleteRe
 }
 
 func (s *ScheduleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	models.ImportResource(ctx, request, response, &models.ScheduleResourceModel{}, s.client.GetSchedule)
+	models.ImportResource(ctx, request, response, &models.ScheduleResourceModel{}, s.client.GetSchedule, scheduleImportLookup(s.client))
+}
+
+func (s *ScheduleResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// State upgrade from a single interval per weekday in hours (0) to a list of intervals
+		// with times of day (1)
+		0: {
+			PriorSchema: &ScheduleSchemaV0,
+			StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				var priorState models.ScheduleResourceModelV0
+
+				response.Diagnostics.Append(request.State.Get(ctx, &priorState)...)
+
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				intervals, diags := models.UpgradeScheduleIntervalsV1(ctx, priorState.Intervals)
+
+				response.Diagnostics.Append(diags...)
+
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				upgradedState := models.ScheduleResourceModel{
+					ID:        priorState.ID,
+					Intervals: intervals,
+					Name:      priorState.Name,
+					TimeZone:  priorState.TimeZone,
+					CreatedAt: priorState.CreatedAt,
+					UpdatedAt: priorState.UpdatedAt,
+				}
+
+				response.Diagnostics.Append(response.State.Set(ctx, upgradedState)...)
+			},
+		},
+	}
+}
+
+func scheduleImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.Schedule] {
+	return models.ImportLookup[zendesk.Schedule]{
+		List: func(ctx context.Context) ([]zendesk.Schedule, error) {
+			return listAll[zendesk.Schedule](ctx, zdClient, "/business_hours/schedules.json", "schedules")
+		},
+		ID: func(schedule zendesk.Schedule) string { return formatID(schedule.Id) },
+		Attributes: map[string]func(zendesk.Schedule) string{
+			"name": func(schedule zendesk.Schedule) string { return schedule.Name },
+		},
+	}
 }
@@ -2,12 +2,19 @@ package provider
 
 import (
 	"fmt"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"regexp"
+	"strings"
 	"testing"
 )
 
@@ -36,8 +43,8 @@ func TestAccSchedule(t *testing.T) {
 						),
 						statecheck.ExpectKnownValue(
 							dummyScheduleResourceName,
-							tfjsonpath.New("intervals").AtMapKey("sunday").AtMapKey("start_time"),
-							knownvalue.Int64Exact(5),
+							tfjsonpath.New("intervals").AtMapKey("sunday").AtSliceIndex(0).AtMapKey("start_time"),
+							knownvalue.StringExact("5"),
 						),
 					},
 				},
@@ -64,8 +71,8 @@ func TestAccSchedule(t *testing.T) {
 						),
 						statecheck.ExpectKnownValue(
 							dummyScheduleResourceName,
-							tfjsonpath.New("intervals").AtMapKey("sunday").AtMapKey("start_time"),
-							knownvalue.Int64Exact(5),
+							tfjsonpath.New("intervals").AtMapKey("sunday").AtSliceIndex(0).AtMapKey("start_time"),
+							knownvalue.StringExact("05:00"),
 						),
 					},
 				},
@@ -92,8 +99,8 @@ func TestAccSchedule(t *testing.T) {
 						),
 						statecheck.ExpectKnownValue(
 							dummyScheduleResourceName,
-							tfjsonpath.New("intervals").AtMapKey("tuesday").AtMapKey("start_time"),
-							knownvalue.Int64Exact(5),
+							tfjsonpath.New("intervals").AtMapKey("tuesday").AtSliceIndex(0).AtMapKey("start_time"),
+							knownvalue.StringExact("05:00"),
 						),
 					},
 				},
@@ -110,12 +117,195 @@ func TestAccSchedule(t *testing.T) {
 						),
 						statecheck.ExpectKnownValue(
 							dummyScheduleResourceName,
-							tfjsonpath.New("intervals").AtMapKey("tuesday").AtMapKey("start_time"),
-							knownvalue.Int64Exact(4),
+							tfjsonpath.New("intervals").AtMapKey("tuesday").AtSliceIndex(0).AtMapKey("start_time"),
+							knownvalue.StringExact("04:00"),
 						),
 					},
 				},
 			},
 		})
 	})
+	t.Run("split shift schedule", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyScheduleResourceName,
+							tfjsonpath.New("intervals").AtMapKey("monday").AtSliceIndex(1).AtMapKey("start_time"),
+							knownvalue.StringExact("13:00"),
+						),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("half hour schedule", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyScheduleResourceName,
+							tfjsonpath.New("intervals").AtMapKey("friday").AtSliceIndex(0).AtMapKey("start_time"),
+							knownvalue.StringExact("08:30"),
+						),
+						statecheck.ExpectKnownValue(
+							dummyScheduleResourceName,
+							tfjsonpath.New("intervals").AtMapKey("friday").AtSliceIndex(0).AtMapKey("end_time"),
+							knownvalue.StringExact("16:45"),
+						),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("end before start", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ExpectError: regexp.MustCompile(`must end after it starts`),
+				},
+			},
+		})
+	})
+
+	t.Run("invalid time zone", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ExpectError: regexp.MustCompile(`Eastern Time \(US & Canada\)`),
+				},
+			},
+		})
+	})
+
+	t.Run("overlapping intervals", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ExpectError: regexp.MustCompile(`must not overlap`),
+				},
+			},
+		})
+	})
+}
+
+func TestScheduleIntervalsValidator(t *testing.T) {
+	intervalType := types.ObjectType{AttrTypes: models.ScheduleIntervalModel{}.AttributeTypes()}
+
+	interval := func(start, end string) attr.Value {
+		return types.ObjectValueMust(intervalType.AttrTypes, map[string]attr.Value{
+			"start_time": models.TimeOfDayValue{StringValue: types.StringValue(start)},
+			"end_time":   models.TimeOfDayValue{StringValue: types.StringValue(end)},
+		})
+	}
+
+	cases := []struct {
+		testName    string
+		intervals   []attr.Value
+		expectError bool
+	}{
+		{testName: "should accept a single interval", intervals: []attr.Value{interval("09:00", "17:00")}},
+		{testName: "should accept split shifts", intervals: []attr.Value{interval("08:00", "12:00"), interval("13:00", "17:00")}},
+		{testName: "should accept adjacent intervals", intervals: []attr.Value{interval("08:00", "12:30"), interval("12:30", "17:00")}},
+		{testName: "should accept whole hours", intervals: []attr.Value{interval("8", "12"), interval("12:30", "17")}},
+		{testName: "should accept intervals until the end of the day", intervals: []attr.Value{interval("00:00", "24:00")}},
+		{testName: "should skip invalid times", intervals: []attr.Value{interval("9am", "17:00")}},
+		{testName: "should reject intervals ending before they start", intervals: []attr.Value{interval("17:00", "09:00")}, expectError: true},
+		{testName: "should reject empty intervals", intervals: []attr.Value{interval("09:00", "09:00")}, expectError: true},
+		{testName: "should reject overlapping intervals", intervals: []attr.Value{interval("08:00", "12:00"), interval("11:30", "17:00")}, expectError: true},
+		{testName: "should reject unordered intervals", intervals: []attr.Value{interval("13:00", "17:00"), interval("08:00", "12:00")}, expectError: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			request := validator.ListRequest{
+				Path:        path.Root("intervals").AtName("monday"),
+				ConfigValue: types.ListValueMust(intervalType, c.intervals),
+			}
+			response := &validator.ListResponse{}
+
+			ScheduleIntervalsValidator{}.ValidateList(t.Context(), request, response)
+
+			if response.Diagnostics.HasError() != c.expectError {
+				t.Fatalf("expected error %t, got diagnostics: %+v", c.expectError, response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestTimeZoneValidator(t *testing.T) {
+	cases := []struct {
+		testName      string
+		timeZone      types.String
+		expectedError string
+	}{
+		{testName: "should accept Zendesk time zones", timeZone: types.StringValue("Pacific Time (US & Canada)")},
+		{testName: "should accept UTC", timeZone: types.StringValue("UTC")},
+		{testName: "should skip unknown values", timeZone: types.StringUnknown()},
+		{testName: "should suggest the Zendesk name of IANA time zones", timeZone: types.StringValue("America/New_York"), expectedError: `"Eastern Time (US & Canada)"`},
+		{testName: "should prefer the city name of IANA time zones", timeZone: types.StringValue("Europe/London"), expectedError: `"London"`},
+		{testName: "should reject typos", timeZone: types.StringValue("Pacific Time"), expectedError: "is not a Zendesk time zone"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			request := validator.StringRequest{
+				Path:        path.Root("time_zone"),
+				ConfigValue: c.timeZone,
+			}
+			response := &validator.StringResponse{}
+
+			TimeZoneValidator{}.ValidateString(t.Context(), request, response)
+
+			if c.expectedError == "" {
+				if response.Diagnostics.HasError() {
+					t.Fatalf("expected no error, got diagnostics: %+v", response.Diagnostics)
+				}
+				return
+			}
+
+			if !response.Diagnostics.HasError() || !strings.Contains(response.Diagnostics.Errors()[0].Detail(), c.expectedError) {
+				t.Fatalf("expected error containing %s, got diagnostics: %+v", c.expectedError, response.Diagnostics)
+			}
+		})
+	}
 }
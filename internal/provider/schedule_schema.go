@@ -1,27 +1,57 @@
 package provider
 
 import (
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
-var intervalSchema = schema.SingleNestedAttribute{
-	Optional: true,
-	Attributes: map[string]schema.Attribute{
-		"start_time": schema.Int64Attribute{
-			Required:    true,
-			Description: "Start time, offset from beginning of day in hours",
-		},
-		"end_time": schema.Int64Attribute{
-			Required:    true,
-			Description: "End time, offset from beginning of day in hours",
-		},
+var intervalAttributes = map[string]schema.Attribute{
+	"start_time": schema.StringAttribute{
+		CustomType:  models.TimeOfDayType{},
+		Required:    true,
+		Description: "Start time of the interval formatted as HH:MM, ex: 09:00. Whole hours, ex: 9, are accepted as well.",
+	},
+	"end_time": schema.StringAttribute{
+		CustomType:  models.TimeOfDayType{},
+		Required:    true,
+		Description: "End time of the interval formatted as HH:MM, ex: 17:30, up to 24:00. It must be after the start time.",
+	},
+}
+
+var intervalAttributesV0 = map[string]schema.Attribute{
+	"start_time": schema.Int64Attribute{
+		Required:    true,
+		Description: "Start time, offset from beginning of day in hours",
+	},
+	"end_time": schema.Int64Attribute{
+		Required:    true,
+		Description: "End time, offset from beginning of day in hours",
+	},
+}
+
+var intervalSchema = schema.ListNestedAttribute{
+	Optional:    true,
+	Description: "Intervals of the day, in chronological order. Intervals of a day must not overlap.",
+	NestedObject: schema.NestedAttributeObject{
+		Attributes: intervalAttributes,
 	},
+	Validators: []validator.List{
+		listvalidator.SizeAtLeast(1),
+		ScheduleIntervalsValidator{},
+	},
+}
+
+var intervalSchemaV0 = schema.SingleNestedAttribute{
+	Optional:   true,
+	Attributes: intervalAttributesV0,
 }
 
 var ScheduleSchema = schema.Schema{
-	Version: 0,
+	Version: 1,
 	MarkdownDescription: `
 You can set a schedule in Zendesk to acknowledge your support team's availability and give customers a better sense of when they can expect a personal response to their support requests.
 
@@ -42,7 +72,10 @@ To learn more about schedules, see [Setting your schedule with business hours an
 		},
 		"time_zone": schema.StringAttribute{
 			Required:            true,
-			MarkdownDescription: `Time zone of the schedule, see [Time Zones](https://developer.zendesk.com/api-reference/introduction/data-types/#time-zones)`,
+			MarkdownDescription: "Time zone of the schedule, ex: `Eastern Time (US & Canada)`, see [Time Zones](https://developer.zendesk.com/api-reference/introduction/data-types/#time-zones)",
+			Validators: []validator.String{
+				TimeZoneValidator{},
+			},
 		},
 		"intervals": schema.SingleNestedAttribute{
 			Optional:    true,
@@ -67,3 +100,44 @@ To learn more about schedules, see [Setting your schedule with business hours an
 		},
 	},
 }
+
+var ScheduleSchemaV0 = schema.Schema{
+	Version: 0,
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "Name of the schedule",
+		},
+		"time_zone": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: `Time zone of the schedule, see [Time Zones](https://developer.zendesk.com/api-reference/introduction/data-types/#time-zones)`,
+		},
+		"intervals": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Schedule intervals divided by day of week.",
+			Attributes: map[string]schema.Attribute{
+				"sunday":    intervalSchemaV0,
+				"monday":    intervalSchemaV0,
+				"tuesday":   intervalSchemaV0,
+				"wednesday": intervalSchemaV0,
+				"thursday":  intervalSchemaV0,
+				"friday":    intervalSchemaV0,
+				"saturday":  intervalSchemaV0,
+			},
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The time the schedule was created.",
+			Computed:    true,
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The time of the last update of the schedule.",
+			Computed:    true,
+		},
+	},
+}
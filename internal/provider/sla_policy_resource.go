@@ -62,7 +62,7 @@ func (s *SLAResource) Delete(ctx context.Context, request resource.DeleteRequest
 }
 
 func (s *SLAResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	models.ImportResource(ctx, request, response, &models.SLAPolicyResourceModel{}, s.client.GetSLAPolicy)
+	models.ImportResource(ctx, request, response, &models.SLAPolicyResourceModel{}, s.client.GetSLAPolicy, slaPolicyImportLookup(s.client))
 }
 
 func (s *SLAResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
@@ -101,3 +101,15 @@ func (s *SLAResource) UpgradeState(ctx context.Context) map[int64]resource.State
 		},
 	}
 }
+
+func slaPolicyImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.SLAPolicy] {
+	return models.ImportLookup[zendesk.SLAPolicy]{
+		List: func(ctx context.Context) ([]zendesk.SLAPolicy, error) {
+			return listAll[zendesk.SLAPolicy](ctx, zdClient, "/slas/policies.json", "sla_policies")
+		},
+		ID: func(policy zendesk.SLAPolicy) string { return formatID(policy.ID) },
+		Attributes: map[string]func(zendesk.SLAPolicy) string{
+			"title": func(policy zendesk.SLAPolicy) string { return policy.Title },
+		},
+	}
+}
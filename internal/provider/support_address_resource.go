@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// supportAddressVerificationPollInterval is the wait between two reads of the forwarding status.
+var supportAddressVerificationPollInterval = 15 * time.Second
+
+var _ resource.ResourceWithImportState = &SupportAddressResource{}
+var _ resource.ResourceWithConfigure = &SupportAddressResource{}
+
+type SupportAddressResource struct {
+	client *zendeskapi.Client
+}
+
+func NewSupportAddressResource() resource.Resource {
+	return &SupportAddressResource{}
+}
+
+func (s *SupportAddressResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*zendesk.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendesk.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = zendeskapi.NewClient(client)
+}
+
+func (s *SupportAddressResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_support_address"
+}
+
+func (s *SupportAddressResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = SupportAddressSchema
+}
+
+func (s *SupportAddressResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	data := &models.SupportAddressResourceModel{}
+	models.CreateResource(ctx, request, response, data, s.client.CreateSupportAddress)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(s.waitForVerification(ctx, data, &response.State)...)
+}
+
+func (s *SupportAddressResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	models.ReadResource(ctx, request, response, &models.SupportAddressResourceModel{}, s.client.GetSupportAddress)
+}
+
+func (s *SupportAddressResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	data := &models.SupportAddressResourceModel{}
+	models.UpdateResource(ctx, request, response, data, s.client.UpdateSupportAddress)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(s.waitForVerification(ctx, data, &response.State)...)
+}
+
+func (s *SupportAddressResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	models.DeleteResource[zendeskapi.SupportAddress](ctx, request, response, &models.SupportAddressResourceModel{}, s.client.DeleteSupportAddress)
+}
+
+func (s *SupportAddressResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	models.ImportResource(ctx, request, response, &models.SupportAddressResourceModel{}, s.client.GetSupportAddress, supportAddressImportLookup(s.client.Client))
+}
+
+// waitForVerification requests forwarding verification of addresses outside of the Zendesk
+// domain when wait_for_verification is set, then reads the address until the forwarding is
+// verified. The state is kept up to date, and a failed or timed out verification is a warning:
+// an error would taint a created address, and the next apply would recreate it instead of
+// checking it again.
+func (s *SupportAddressResource) waitForVerification(ctx context.Context, data *models.SupportAddressResourceModel, state *tfsdk.State) (diags diag.Diagnostics) {
+	if !data.WaitForVerification.ValueBool() ||
+		data.ForwardingStatus.ValueString() == zendeskapi.SupportAddressStatusVerified ||
+		strings.HasSuffix(data.Email.ValueString(), ".zendesk.com") {
+		return diags
+	}
+
+	timeout, err := time.ParseDuration(data.VerificationTimeout.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("verification_timeout"), "Invalid verification timeout", err.Error())
+		return diags
+	}
+
+	err = s.client.VerifySupportAddressForwarding(ctx, data.GetID())
+	if err != nil {
+		diags.Append(models.APIErrorDiagnostics(ctx, "Error requesting support address verification", err, state.Schema)...)
+		return diags
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+		select {
+		case <-ctx.Done():
+			diags.AddError("Error waiting for support address verification", ctx.Err().Error())
+			return diags
+		case <-time.After(supportAddressVerificationPollInterval):
+		}
+
+		address, err := s.client.GetSupportAddress(ctx, data.GetID())
+		if err != nil {
+			diags.Append(models.APIErrorDiagnostics(ctx, "Error reading support address", err, state.Schema)...)
+			return diags
+		}
+
+		diags.Append(data.GetTfModelFromApiModel(ctx, address)...)
+		diags.Append(state.Set(ctx, data)...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		tflog.Debug(ctx, "Waiting for support address verification", map[string]any{
+			"id":                data.GetID(),
+			"forwarding_status": address.ForwardingStatus,
+		})
+
+		switch {
+		case address.ForwardingStatus == zendeskapi.SupportAddressStatusVerified:
+			return diags
+		case address.ForwardingStatus == zendeskapi.SupportAddressStatusFailed:
+			diags.AddWarning(
+				"Support address verification failed",
+				fmt.Sprintf("Emails to %s are not forwarded to Zendesk, check the forwarding rule of the mail server. "+
+					"forwarding_status is refreshed on every plan, and verification is requested again on the next update of the address.", address.Email),
+			)
+			return diags
+		case time.Now().After(deadline):
+			diags.AddWarning(
+				"Support address verification timed out",
+				fmt.Sprintf("Forwarding of %s is still %s after %s, increase verification_timeout if it usually takes longer. "+
+					"forwarding_status is refreshed on every plan.", address.Email, address.ForwardingStatus, timeout),
+			)
+			return diags
+		}
+	}
+}
+
+func supportAddressImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendeskapi.SupportAddress] {
+	return models.ImportLookup[zendeskapi.SupportAddress]{
+		List: zendeskapi.NewClient(zdClient).GetSupportAddresses,
+		ID:   func(address zendeskapi.SupportAddress) string { return formatID(address.ID) },
+		Attributes: map[string]func(zendeskapi.SupportAddress) string{
+			"email": func(address zendeskapi.SupportAddress) string { return address.Email },
+		},
+	}
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+var dummySupportAddressResourceName = "zendesk_support_address.test"
+
+func TestAccSupportAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("basic_support_address", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummySupportAddressResourceName,
+							tfjsonpath.New("email"),
+							knownvalue.StringExact(fullResourceName+"@example.com"),
+						),
+						statecheck.ExpectKnownValue(
+							dummySupportAddressResourceName,
+							tfjsonpath.New("default"),
+							knownvalue.Bool(false),
+						),
+					},
+				},
+				{
+					ResourceName:            dummySupportAddressResourceName,
+					ImportState:             true,
+					ImportStateId:           "email:" + fullResourceName + "@example.com",
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"forwarding_status", "spf_status", "cname_status", "domain_verification_status", "updated_at"},
+					ConfigFile:              config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestSupportAddressResource_Create(t *testing.T) {
+	supportAddressVerificationPollInterval = time.Millisecond
+
+	cases := []struct {
+		testName         string
+		plan             map[string]tftypes.Value
+		statuses         []string
+		expectedRequests []string
+		expectWarning    bool
+	}{
+		{
+			testName: "should not wait by default",
+			plan:     map[string]tftypes.Value{"wait_for_verification": tftypes.NewValue(tftypes.Bool, false)},
+			expectedRequests: []string{
+				"POST /api/v2/recipient_addresses.json",
+			},
+		},
+		{
+			testName: "should wait until forwarding is verified",
+			plan:     map[string]tftypes.Value{"wait_for_verification": tftypes.NewValue(tftypes.Bool, true)},
+			statuses: []string{"waiting", "verified"},
+			expectedRequests: []string{
+				"POST /api/v2/recipient_addresses.json",
+				"PUT /api/v2/recipient_addresses/1/verify.json",
+				"GET /api/v2/recipient_addresses/1.json",
+				"GET /api/v2/recipient_addresses/1.json",
+			},
+		},
+		{
+			testName: "should warn when forwarding verification fails",
+			plan:     map[string]tftypes.Value{"wait_for_verification": tftypes.NewValue(tftypes.Bool, true)},
+			statuses: []string{"failed"},
+			expectedRequests: []string{
+				"POST /api/v2/recipient_addresses.json",
+				"PUT /api/v2/recipient_addresses/1/verify.json",
+				"GET /api/v2/recipient_addresses/1.json",
+			},
+			expectWarning: true,
+		},
+		{
+			testName: "should warn when forwarding verification times out",
+			plan: map[string]tftypes.Value{
+				"wait_for_verification": tftypes.NewValue(tftypes.Bool, true),
+				"verification_timeout":  tftypes.NewValue(tftypes.String, "0s"),
+			},
+			statuses: []string{"waiting"},
+			expectedRequests: []string{
+				"POST /api/v2/recipient_addresses.json",
+				"PUT /api/v2/recipient_addresses/1/verify.json",
+				"GET /api/v2/recipient_addresses/1.json",
+			},
+			expectWarning: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			var requests []string
+			statuses := c.statuses
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+
+				w.Header().Set("Content-Type", "application/json")
+				switch r.Method {
+				case http.MethodPost:
+					w.WriteHeader(http.StatusCreated)
+					_, _ = w.Write([]byte(`{"recipient_address":{"id":1,"email":"help@example.com","name":"Support","brand_id":2,"forwarding_status":"unknown"}}`))
+				case http.MethodPut:
+					_, _ = w.Write([]byte(`{}`))
+				case http.MethodGet:
+					status := statuses[0]
+					statuses = statuses[1:]
+					_, _ = fmt.Fprintf(w, `{"recipient_address":{"id":1,"email":"help@example.com","name":"Support","brand_id":2,"forwarding_status":%q}}`, status)
+				}
+			})
+			r := &SupportAddressResource{client: zendeskapi.NewClient(testZendeskClient(t, handler))}
+
+			values := map[string]tftypes.Value{
+				"email":                tftypes.NewValue(tftypes.String, "help@example.com"),
+				"name":                 tftypes.NewValue(tftypes.String, "Support"),
+				"brand_id":             tftypes.NewValue(tftypes.Number, 2),
+				"verification_timeout": tftypes.NewValue(tftypes.String, "1m"),
+			}
+			for name, value := range c.plan {
+				values[name] = value
+			}
+			plan := testResourceState(t, SupportAddressSchema, values)
+			response := &fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+
+			r.Create(t.Context(), fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %+v", response.Diagnostics)
+			}
+
+			if (response.Diagnostics.WarningsCount() > 0) != c.expectWarning {
+				t.Fatalf("expected warning %t, got diagnostics: %+v", c.expectWarning, response.Diagnostics)
+			}
+
+			if !slices.Equal(requests, c.expectedRequests) {
+				t.Fatalf("expected requests %v, got %v", c.expectedRequests, requests)
+			}
+
+			var state models.SupportAddressResourceModel
+			response.State.Get(t.Context(), &state)
+
+			if state.ID.ValueInt64() != 1 {
+				t.Fatalf("expected the created address to be kept in state, got %+v", state)
+			}
+		})
+	}
+}
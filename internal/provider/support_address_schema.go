@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var SupportAddressSchema = schema.Schema{
+	Version: 0,
+	MarkdownDescription: "Support address, an email address tickets of a brand are received on. " +
+		"Addresses outside of the Zendesk domain must forward their emails to Zendesk, " +
+		"the forwarding, SPF and CNAME statuses report whether the address is set up for delivery. " +
+		"See [Support Addresses](https://developer.zendesk.com/api-reference/ticketing/account-configuration/support_addresses/) " +
+		"for more information.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"email": schema.StringAttribute{
+			Required:    true,
+			Description: "Email address of the support address. Changing the email will delete the address and create a new one.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "Name shown as the sender of emails sent from the address",
+		},
+		"brand_id": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "ID of the brand the address belongs to, defaults to the default brand",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"default": schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+			Description: "If true, the address is the default support address of the account. " +
+				"Another address must be made the default to unset it",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"forwarding_status": schema.StringAttribute{
+			Computed:    true,
+			Description: "Status of the email forwarding to Zendesk: unknown, waiting, verified or failed",
+		},
+		"spf_status": schema.StringAttribute{
+			Computed:    true,
+			Description: "Status of the SPF record of the address domain: unknown, verified or failed",
+		},
+		"cname_status": schema.StringAttribute{
+			Computed:    true,
+			Description: "Status of the CNAME records of the address domain: unknown, verified or failed",
+		},
+		"domain_verification_status": schema.StringAttribute{
+			Computed:    true,
+			Description: "Status of the domain verification record of the address domain: unknown, verified or failed",
+		},
+		"wait_for_verification": schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+			Description: "If true, forwarding verification is requested when the address is created or updated, " +
+				"and Terraform waits until it is verified. Verification failing or timing out is reported as a warning, " +
+				"the address is kept and forwarding_status shows its latest status. " +
+				"Addresses on the Zendesk domain don't need forwarding and are not waited for. Defaults to false",
+			Default: booldefault.StaticBool(false),
+		},
+		"verification_timeout": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Description: "How long to wait for forwarding verification, formatted as a duration, ex: 10m or 1h30m. " +
+				"Defaults to " + models.SupportAddressDefaultVerificationTimeout,
+			Default: stringdefault.StaticString(models.SupportAddressDefaultVerificationTimeout),
+			Validators: []validator.String{
+				DurationValidator{},
+			},
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The time the support address was created.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The time of the last update of the support address.",
+			Computed:    true,
+		},
+	},
+}
@@ -0,0 +1,11 @@
+resource "zendesk_custom_object" "test" {
+  key              = var.key
+  title            = "Asset"
+  title_pluralized = "Assets"
+  description      = "Hardware lent to employees"
+}
+
+variable "key" {
+  type     = string
+  nullable = false
+}
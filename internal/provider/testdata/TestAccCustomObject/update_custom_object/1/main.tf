@@ -0,0 +1,10 @@
+resource "zendesk_custom_object" "test" {
+  key              = var.key
+  title            = "Asset"
+  title_pluralized = "Assets"
+}
+
+variable "key" {
+  type     = string
+  nullable = false
+}
@@ -0,0 +1,11 @@
+resource "zendesk_custom_object" "test" {
+  key              = var.key
+  title            = "Hardware asset"
+  title_pluralized = "Hardware assets"
+  description      = "Hardware lent to employees"
+}
+
+variable "key" {
+  type     = string
+  nullable = false
+}
@@ -0,0 +1,55 @@
+resource "zendesk_custom_object" "contract" {
+  key              = "${var.key}_contract"
+  title            = "Contract"
+  title_pluralized = "Contracts"
+}
+
+resource "zendesk_custom_object" "asset" {
+  key              = "${var.key}_asset"
+  title            = "Asset"
+  title_pluralized = "Assets"
+}
+
+resource "zendesk_custom_object_field" "test" {
+  custom_object_key = zendesk_custom_object.asset.key
+  key               = "serial_number"
+  type              = "text"
+  name              = "Serial number"
+}
+
+resource "zendesk_custom_object_field" "status" {
+  custom_object_key = zendesk_custom_object.asset.key
+  key               = "status"
+  type              = "dropdown"
+  name              = "Status"
+  custom_field_options = [
+    {
+      name  = "In use"
+      value = "${var.key}_in_use"
+    },
+    {
+      name  = "Retired"
+      value = "${var.key}_retired"
+    },
+  ]
+}
+
+resource "zendesk_custom_object_field" "contract" {
+  custom_object_key        = zendesk_custom_object.asset.key
+  key                      = "contract"
+  type                     = "lookup"
+  name                     = "Contract"
+  relationship_target_type = "zen:custom_object:${zendesk_custom_object.contract.key}"
+}
+
+resource "zendesk_user_field" "asset" {
+  key                      = "${var.key}_asset"
+  type                     = "lookup"
+  name                     = "${var.key} asset"
+  relationship_target_type = "zen:custom_object:${zendesk_custom_object.asset.key}"
+}
+
+variable "key" {
+  type     = string
+  nullable = false
+}
@@ -0,0 +1,18 @@
+resource "zendesk_custom_role" "test" {
+  name        = var.title
+  description = "Managed by Terraform"
+
+  configuration = {
+    ticket_access           = "within-groups"
+    ticket_comment_access   = "public"
+    ticket_editing          = true
+    macro_access            = "manage-personal"
+    view_access             = "manage-personal"
+    end_user_profile_access = "edit"
+  }
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
@@ -0,0 +1,12 @@
+resource "zendesk_custom_role" "test" {
+  name = var.title
+
+  configuration = {
+    ticket_access = "assigned-only"
+  }
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
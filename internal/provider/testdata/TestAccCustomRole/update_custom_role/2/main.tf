@@ -0,0 +1,15 @@
+resource "zendesk_custom_role" "test" {
+  name = "${var.title} updated"
+
+  configuration = {
+    ticket_access            = "all"
+    ticket_comment_access    = "public"
+    ticket_merge             = true
+    manage_group_memberships = true
+  }
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
@@ -0,0 +1,12 @@
+resource "zendesk_custom_status" "test" {
+  status_category      = "pending"
+  agent_label          = var.title
+  end_user_label       = "Waiting on a partner"
+  description          = "Waiting on a partner to answer"
+  end_user_description = "We are waiting on a partner to answer"
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
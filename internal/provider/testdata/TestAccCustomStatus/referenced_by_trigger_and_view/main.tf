@@ -0,0 +1,53 @@
+resource "zendesk_custom_status" "test" {
+  status_category = "pending"
+  agent_label     = var.title
+}
+
+resource "zendesk_trigger" "test" {
+  title = var.title
+  actions = [
+    {
+      field = "custom_status_id"
+      value = zendesk_custom_status.test.id
+    }
+  ]
+  conditions = {
+    all = [
+      {
+        field    = "custom_status_id",
+        operator = "includes",
+        values   = [zendesk_custom_status.test.id]
+      },
+      {
+        field    = "update_type",
+        operator = "is",
+        value    = "Change"
+      }
+  ] }
+  category_id = zendesk_trigger_category.test.id
+}
+
+resource "zendesk_trigger_category" "test" {
+  name = "${var.title}_category"
+}
+
+resource "zendesk_view" "test" {
+  title = var.title
+  conditions = {
+    all = [
+      {
+        field    = "custom_status_id",
+        operator = "includes",
+        values   = [zendesk_custom_status.test.id]
+      }
+  ] }
+
+  output = {
+    columns = ["status", "assignee"]
+  }
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
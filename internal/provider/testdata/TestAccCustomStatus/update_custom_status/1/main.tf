@@ -0,0 +1,9 @@
+resource "zendesk_custom_status" "test" {
+  status_category = "hold"
+  agent_label     = var.title
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
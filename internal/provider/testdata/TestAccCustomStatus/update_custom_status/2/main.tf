@@ -0,0 +1,11 @@
+resource "zendesk_custom_status" "test" {
+  status_category = "hold"
+  agent_label     = "${var.title} updated"
+  description     = "Updated"
+  active          = false
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
@@ -0,0 +1,21 @@
+resource "zendesk_group" "test" {
+  name = var.title
+}
+
+resource "zendesk_user" "test" {
+  name           = var.title
+  email          = "${lower(var.title)}@example.com"
+  role           = "agent"
+  destroy_action = "delete"
+}
+
+resource "zendesk_group_membership" "test" {
+  group_id = zendesk_group.test.id
+  user_id  = zendesk_user.test.id
+  default  = true
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
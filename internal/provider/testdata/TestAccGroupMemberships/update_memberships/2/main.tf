@@ -0,0 +1,27 @@
+resource "zendesk_group" "test" {
+  name = var.title
+}
+
+resource "zendesk_user" "first" {
+  name           = "${var.title} first"
+  email          = "${lower(var.title)}-first@example.com"
+  role           = "agent"
+  destroy_action = "delete"
+}
+
+resource "zendesk_user" "second" {
+  name           = "${var.title} second"
+  email          = "${lower(var.title)}-second@example.com"
+  role           = "agent"
+  destroy_action = "delete"
+}
+
+resource "zendesk_group_memberships" "test" {
+  group_id = zendesk_group.test.id
+  user_ids = [zendesk_user.second.id]
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
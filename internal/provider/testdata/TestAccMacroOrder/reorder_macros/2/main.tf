@@ -0,0 +1,31 @@
+resource "zendesk_macro" "first" {
+  title = "${var.title}_first"
+  actions = [
+    {
+      field = "status"
+      value = "open"
+    }
+  ]
+}
+
+resource "zendesk_macro" "second" {
+  title = "${var.title}_second"
+  actions = [
+    {
+      field = "status"
+      value = "open"
+    }
+  ]
+}
+
+resource "zendesk_macro_order" "test" {
+  macro_ids = [
+    zendesk_macro.second.id,
+    zendesk_macro.first.id,
+  ]
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
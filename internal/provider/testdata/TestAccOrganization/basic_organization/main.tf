@@ -0,0 +1,11 @@
+resource "zendesk_organization" "test" {
+  name         = var.title
+  external_id  = var.title
+  domain_names = ["${lower(var.title)}.example.com"]
+  tags         = ["terraform"]
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
@@ -0,0 +1,8 @@
+resource "zendesk_organization" "test" {
+  name = var.title
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
@@ -0,0 +1,17 @@
+resource "zendesk_group" "test" {
+  name = var.title
+}
+
+resource "zendesk_organization" "test" {
+  name            = var.title
+  group_id        = zendesk_group.test.id
+  shared_tickets  = true
+  shared_comments = true
+  details         = "1 Main Street"
+  notes           = "Managed by Terraform"
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
@@ -0,0 +1,8 @@
+resource "zendesk_routing_attribute" "test" {
+  name = var.title
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
@@ -0,0 +1,22 @@
+resource "zendesk_routing_attribute" "language" {
+  name = var.title
+}
+
+resource "zendesk_routing_attribute_value" "test" {
+  attribute_id = zendesk_routing_attribute.language.id
+  name         = "French"
+  conditions = {
+    all = [
+      {
+        field    = "locale_id"
+        operator = "is"
+        value    = "fr"
+      }
+    ]
+  }
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
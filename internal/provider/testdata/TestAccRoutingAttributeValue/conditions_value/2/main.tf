@@ -0,0 +1,27 @@
+resource "zendesk_routing_attribute" "language" {
+  name = var.title
+}
+
+resource "zendesk_routing_attribute_value" "test" {
+  attribute_id = zendesk_routing_attribute.language.id
+  name         = "French"
+  conditions = {
+    any = [
+      {
+        field    = "locale_id"
+        operator = "is"
+        value    = "fr"
+      },
+      {
+        field    = "locale_id"
+        operator = "is"
+        value    = "fr-ca"
+      }
+    ]
+  }
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
@@ -0,0 +1,29 @@
+resource "zendesk_group" "primary" {
+  name = "${var.title} primary"
+}
+
+resource "zendesk_group" "secondary" {
+  name = "${var.title} secondary"
+}
+
+resource "zendesk_routing_queue" "test" {
+  name             = var.title
+  description      = "Urgent tickets"
+  priority         = 2
+  primary_groups   = [zendesk_group.primary.id]
+  secondary_groups = [zendesk_group.secondary.id]
+  conditions = {
+    all = [
+      {
+        field    = "priority"
+        operator = "is"
+        value    = "urgent"
+      }
+    ]
+  }
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
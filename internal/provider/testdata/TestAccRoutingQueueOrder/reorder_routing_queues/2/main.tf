@@ -0,0 +1,45 @@
+resource "zendesk_group" "test" {
+  name = var.title
+}
+
+resource "zendesk_routing_queue" "first" {
+  name           = "${var.title}_first"
+  priority       = 1
+  primary_groups = [zendesk_group.test.id]
+  conditions = {
+    all = [
+      {
+        field    = "priority"
+        operator = "is"
+        value    = "urgent"
+      }
+    ]
+  }
+}
+
+resource "zendesk_routing_queue" "second" {
+  name           = "${var.title}_second"
+  priority       = 1
+  primary_groups = [zendesk_group.test.id]
+  conditions = {
+    all = [
+      {
+        field    = "priority"
+        operator = "is"
+        value    = "high"
+      }
+    ]
+  }
+}
+
+resource "zendesk_routing_queue_order" "test" {
+  queue_ids = [
+    zendesk_routing_queue.second.id,
+    zendesk_routing_queue.first.id,
+  ]
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
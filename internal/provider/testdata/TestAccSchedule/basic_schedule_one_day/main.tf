@@ -3,10 +3,10 @@ resource "zendesk_schedule" "test" {
   time_zone = "Pacific Time (US & Canada)"
 
   intervals = {
-    sunday = {
+    sunday = [{
       start_time = 5
       end_time   = 13
-    }
+    }]
   }
 }
 
@@ -0,0 +1,16 @@
+resource "zendesk_schedule" "test" {
+  name      = var.title
+  time_zone = "Pacific Time (US & Canada)"
+
+  intervals = {
+    monday = [{
+      start_time = "17:00"
+      end_time   = "09:00"
+    }]
+  }
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
@@ -0,0 +1,16 @@
+resource "zendesk_schedule" "test" {
+  name      = var.title
+  time_zone = "Eastern Time (US & Canada)"
+
+  intervals = {
+    friday = [{
+      start_time = "08:30"
+      end_time   = "16:45"
+    }]
+  }
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
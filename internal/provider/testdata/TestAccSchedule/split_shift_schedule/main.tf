@@ -0,0 +1,19 @@
+resource "zendesk_schedule" "test" {
+  name      = var.title
+  time_zone = "Pacific Time (US & Canada)"
+
+  intervals = {
+    monday = [{
+      start_time = "08:00"
+      end_time   = "12:00"
+      }, {
+      start_time = "13:00"
+      end_time   = "17:00"
+    }]
+  }
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
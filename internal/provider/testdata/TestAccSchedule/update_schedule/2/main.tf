@@ -3,34 +3,34 @@ resource "zendesk_schedule" "test" {
   time_zone = "Pacific Time (US & Canada)"
 
   intervals = {
-    sunday = {
-      start_time = 5
-      end_time   = 13
-    }
-    monday = {
-      start_time = 5
-      end_time   = 13
-    }
-    tuesday = {
-      start_time = 4
-      end_time   = 19
-    }
-    wednesday = {
-      start_time = 5
-      end_time   = 13
-    }
-    thursday = {
-      start_time = 5
-      end_time   = 13
-    }
-    friday = {
-      start_time = 5
-      end_time   = 13
-    }
-    saturday = {
-      start_time = 5
-      end_time   = 13
-    }
+    sunday = [{
+      start_time = "05:00"
+      end_time   = "13:00"
+    }]
+    monday = [{
+      start_time = "05:00"
+      end_time   = "13:00"
+    }]
+    tuesday = [{
+      start_time = "04:00"
+      end_time   = "19:00"
+    }]
+    wednesday = [{
+      start_time = "05:00"
+      end_time   = "13:00"
+    }]
+    thursday = [{
+      start_time = "05:00"
+      end_time   = "13:00"
+    }]
+    friday = [{
+      start_time = "05:00"
+      end_time   = "13:00"
+    }]
+    saturday = [{
+      start_time = "05:00"
+      end_time   = "13:00"
+    }]
   }
 }
 
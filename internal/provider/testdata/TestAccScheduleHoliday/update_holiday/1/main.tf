@@ -0,0 +1,23 @@
+resource "zendesk_schedule" "test" {
+  name      = var.title
+  time_zone = "Pacific Time (US & Canada)"
+
+  intervals = {
+    monday = [{
+      start_time = "09:00"
+      end_time   = "17:00"
+    }]
+  }
+}
+
+resource "zendesk_schedule_holiday" "test" {
+  schedule_id = zendesk_schedule.test.id
+  name        = "Christmas"
+  start_date  = "2030-12-25"
+  end_date    = "2030-12-26"
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
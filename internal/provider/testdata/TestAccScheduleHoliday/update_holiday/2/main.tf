@@ -0,0 +1,23 @@
+resource "zendesk_schedule" "test" {
+  name      = var.title
+  time_zone = "Pacific Time (US & Canada)"
+
+  intervals = {
+    monday = [{
+      start_time = "09:00"
+      end_time   = "17:00"
+    }]
+  }
+}
+
+resource "zendesk_schedule_holiday" "test" {
+  schedule_id = zendesk_schedule.test.id
+  name        = "Winter break"
+  start_date  = "2030-12-24"
+  end_date    = "2031-01-01"
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
@@ -0,0 +1,15 @@
+resource "zendesk_brand" "test" {
+  name      = var.title
+  subdomain = replace(var.title, "_", "")
+}
+
+resource "zendesk_support_address" "test" {
+  email    = "${var.title}@example.com"
+  name     = var.title
+  brand_id = zendesk_brand.test.id
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
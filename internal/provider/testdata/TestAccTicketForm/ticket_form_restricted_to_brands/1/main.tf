@@ -0,0 +1,25 @@
+resource "zendesk_brand" "test" {
+  name      = var.title
+  subdomain = replace(var.title, "_", "")
+}
+
+resource "zendesk_ticket_form" "test" {
+  form_name = var.title
+
+  ticket_field_ids = [
+    37446012469780,
+    37446012469908,
+    37446026474900,
+    37446026477460,
+    37446012470548,
+    37446026475284,
+  ]
+
+  in_all_brands        = false
+  restricted_brand_ids = [zendesk_brand.test.id]
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
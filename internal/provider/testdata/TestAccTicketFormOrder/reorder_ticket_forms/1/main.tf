@@ -0,0 +1,37 @@
+resource "zendesk_ticket_form" "first" {
+  form_name = "${var.title}_first"
+
+  ticket_field_ids = [
+    37446012469780,
+    37446012469908,
+    37446026474900,
+    37446026477460,
+    37446012470548,
+    37446026475284,
+  ]
+}
+
+resource "zendesk_ticket_form" "second" {
+  form_name = "${var.title}_second"
+
+  ticket_field_ids = [
+    37446012469780,
+    37446012469908,
+    37446026474900,
+    37446026477460,
+    37446012470548,
+    37446026475284,
+  ]
+}
+
+resource "zendesk_ticket_form_order" "test" {
+  ticket_form_ids = [
+    zendesk_ticket_form.first.id,
+    zendesk_ticket_form.second.id,
+  ]
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
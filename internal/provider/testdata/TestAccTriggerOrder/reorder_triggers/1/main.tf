@@ -0,0 +1,65 @@
+resource "zendesk_trigger_category" "first" {
+  name = "${var.title}_first"
+}
+
+resource "zendesk_trigger_category" "second" {
+  name = "${var.title}_second"
+}
+
+resource "zendesk_trigger" "first" {
+  title       = "${var.title}_first"
+  category_id = zendesk_trigger_category.first.id
+  actions = [
+    {
+      field = "reply_internal"
+      value = "Test body internal"
+    }
+  ]
+  conditions = {
+    all = [
+      {
+        field    = "status",
+        operator = "is",
+        value    = "open"
+      }
+    ]
+  }
+}
+
+resource "zendesk_trigger" "second" {
+  title       = "${var.title}_second"
+  category_id = zendesk_trigger_category.first.id
+  actions = [
+    {
+      field = "reply_internal"
+      value = "Test body internal"
+    }
+  ]
+  conditions = {
+    all = [
+      {
+        field    = "status",
+        operator = "is",
+        value    = "open"
+      }
+    ]
+  }
+}
+
+resource "zendesk_trigger_order" "test" {
+  categories = [
+    {
+      category_id = zendesk_trigger_category.first.id
+      trigger_ids = [zendesk_trigger.first.id, zendesk_trigger.second.id]
+    },
+    {
+      category_id = zendesk_trigger_category.second.id
+      trigger_ids = []
+    },
+  ]
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
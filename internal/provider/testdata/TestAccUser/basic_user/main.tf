@@ -0,0 +1,13 @@
+resource "zendesk_user" "test" {
+  name           = var.title
+  email          = "${lower(var.title)}@example.com"
+  role           = "agent"
+  time_zone      = "Eastern Time (US & Canada)"
+  tags           = ["terraform"]
+  destroy_action = "delete"
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
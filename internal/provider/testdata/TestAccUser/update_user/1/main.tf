@@ -0,0 +1,11 @@
+resource "zendesk_user" "test" {
+  name           = var.title
+  email          = "${lower(var.title)}@example.com"
+  role           = "agent"
+  destroy_action = "delete"
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
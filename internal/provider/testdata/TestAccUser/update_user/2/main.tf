@@ -0,0 +1,11 @@
+resource "zendesk_user" "test" {
+  name           = "${var.title} updated"
+  email          = "${lower(var.title)}@example.com"
+  role           = "admin"
+  destroy_action = "delete"
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
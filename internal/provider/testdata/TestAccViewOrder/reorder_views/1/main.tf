@@ -0,0 +1,51 @@
+resource "zendesk_view" "first" {
+  title = "${var.title}_first"
+  conditions = {
+    all = [
+      {
+        field    = "status",
+        operator = "is",
+        value    = "open"
+      }
+  ] }
+
+  output = {
+    columns     = ["status", "assignee"]
+    group_by    = "status"
+    group_order = "asc"
+    sort_by     = "assignee"
+    sort_order  = "asc"
+  }
+}
+
+resource "zendesk_view" "second" {
+  title = "${var.title}_second"
+  conditions = {
+    all = [
+      {
+        field    = "status",
+        operator = "is",
+        value    = "open"
+      }
+  ] }
+
+  output = {
+    columns     = ["status", "assignee"]
+    group_by    = "status"
+    group_order = "asc"
+    sort_by     = "assignee"
+    sort_order  = "asc"
+  }
+}
+
+resource "zendesk_view_order" "test" {
+  view_ids = [
+    zendesk_view.first.id,
+    zendesk_view.second.id,
+  ]
+}
+
+variable "title" {
+  type     = string
+  nullable = false
+}
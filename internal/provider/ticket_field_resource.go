@@ -79,7 +79,7 @@ func (r *TicketFieldResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *TicketFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	models.ImportResource(ctx, req, resp, &models.TicketFieldResourceModel{}, r.client.GetTicketField)
+	models.ImportResource(ctx, req, resp, &models.TicketFieldResourceModel{}, r.client.GetTicketField, ticketFieldImportLookup(r.client))
 
 }
 
@@ -111,3 +111,15 @@ func (r *TicketFieldResource) ValidateConfig(ctx context.Context, request resour
 	}
 
 }
+
+func ticketFieldImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.TicketField] {
+	return models.ImportLookup[zendesk.TicketField]{
+		List: func(ctx context.Context) ([]zendesk.TicketField, error) {
+			return listAll[zendesk.TicketField](ctx, zdClient, "/ticket_fields.json", "ticket_fields")
+		},
+		ID: func(field zendesk.TicketField) string { return formatID(field.ID) },
+		Attributes: map[string]func(zendesk.TicketField) string{
+			"title": func(field zendesk.TicketField) string { return field.Title },
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// NewTicketFormOrderResource manages the order of the ticket forms, there is one per account.
+func NewTicketFormOrderResource() resource.Resource {
+	return &orderResource[zendesk.TicketForm, int64]{
+		typeName:  "_ticket_form_order",
+		id:        models.TicketFormOrderID,
+		attribute: "ticket_form_ids",
+		items:     "ticket forms",
+		schema:    TicketFormOrderSchema,
+		list: func(ctx context.Context, client *zendeskapi.Client) ([]zendesk.TicketForm, error) {
+			return listAll[zendesk.TicketForm](ctx, client.Client, "/ticket_forms.json", "ticket_forms")
+		},
+		currentOrder: models.TicketFormIDsByPosition,
+		update: func(ctx context.Context, client *zendeskapi.Client, ids []int64) error {
+			return client.ReorderTicketForms(ctx, ids)
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var TicketFormOrderSchema = schema.Schema{
+	Version: 0,
+	MarkdownDescription: "Order of the ticket forms of the account, set with a single reorder request. " +
+		"The listed forms come first, forms not listed keep their relative order after them. " +
+		"Don't set `position` on the `zendesk_ticket_form` resources ordered here. " +
+		"Destroying the resource keeps the current order. " +
+		"See [Reorder Ticket Forms](https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_forms/#reorder-ticket-forms) for more information.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the ticket form order, always ticket_form_order",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ticket_form_ids": schema.ListAttribute{
+			Required:    true,
+			ElementType: types.Int64Type,
+			Description: "IDs of the ticket forms in the order they are shown",
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+			},
+		},
+	},
+}
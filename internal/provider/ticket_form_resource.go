@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/JacobPotter/go-zendesk/zendesk"
 	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -14,7 +15,7 @@ var _ resource.ResourceWithConfigure = &TicketFormResource{}
 var _ resource.ResourceWithUpgradeState = &TicketFormResource{}
 
 type TicketFormResource struct {
-	client *zendesk.Client
+	client *zendeskapi.Client
 }
 
 func NewTicketFormResource() resource.Resource {
@@ -46,11 +47,15 @@ func (t *TicketFormResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	t.client = client
+	t.client = zendeskapi.NewClient(client)
 }
 
 func (t *TicketFormResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
-	models.CreateResource(ctx, request, response, &models.TicketFormResourceModel{}, t.client.CreateTicketForm)
+	data := &models.TicketFormResourceModel{}
+
+	models.CreateResource(ctx, request, response, data, func(ctx context.Context, form zendesk.TicketForm) (zendesk.TicketForm, error) {
+		return t.client.CreateTicketForm(ctx, form, data.GetInAllBrands())
+	})
 }
 
 func (t *TicketFormResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -58,7 +63,11 @@ func (t *TicketFormResource) Read(ctx context.Context, request resource.ReadRequ
 }
 
 func (t *TicketFormResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
-	models.UpdateResource(ctx, request, response, &models.TicketFormResourceModel{}, t.client.UpdateTicketForm)
+	data := &models.TicketFormResourceModel{}
+
+	models.UpdateResource(ctx, request, response, data, func(ctx context.Context, id int64, form zendesk.TicketForm) (zendesk.TicketForm, error) {
+		return t.client.UpdateTicketForm(ctx, id, form, data.GetInAllBrands())
+	})
 }
 
 func (t *TicketFormResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -66,7 +75,7 @@ func (t *TicketFormResource) Delete(ctx context.Context, request resource.Delete
 }
 
 func (t *TicketFormResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	models.ImportResource(ctx, request, response, &models.TicketFormResourceModel{}, t.client.GetTicketForm)
+	models.ImportResource(ctx, request, response, &models.TicketFormResourceModel{}, t.client.GetTicketForm, ticketFormImportLookup(t.client.Client))
 }
 
 func (t *TicketFormResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
@@ -131,19 +140,21 @@ func (t *TicketFormResource) UpgradeState(_ context.Context) map[int64]resource.
 				}
 
 				newState := models.TicketFormResourceModel{
-					ID:                priorState.ID,
-					Name:              priorState.Name,
-					DisplayName:       priorState.DisplayName,
-					TicketFieldIds:    priorState.TicketFieldIds,
-					AgentConditions:   newAgentMapTf,
-					EndUserConditions: newEndUserMapTf,
-					Active:            priorState.Active,
-					Position:          priorState.Position,
-					Default:           priorState.Default,
-					EndUserVisible:    priorState.EndUserVisible,
-					CreatedAt:         priorState.CreatedAt,
-					UpdatedAt:         priorState.UpdatedAt,
-					Url:               priorState.Url,
+					ID:                 priorState.ID,
+					Name:               priorState.Name,
+					DisplayName:        priorState.DisplayName,
+					TicketFieldIds:     priorState.TicketFieldIds,
+					AgentConditions:    newAgentMapTf,
+					EndUserConditions:  newEndUserMapTf,
+					Active:             priorState.Active,
+					Position:           priorState.Position,
+					Default:            priorState.Default,
+					EndUserVisible:     priorState.EndUserVisible,
+					InAllBrands:        types.BoolNull(),
+					RestrictedBrandIds: types.SetNull(types.Int64Type),
+					CreatedAt:          priorState.CreatedAt,
+					UpdatedAt:          priorState.UpdatedAt,
+					Url:                priorState.Url,
 				}
 
 				response.Diagnostics.Append(response.State.Set(ctx, newState)...)
@@ -152,3 +163,15 @@ func (t *TicketFormResource) UpgradeState(_ context.Context) map[int64]resource.
 		},
 	}
 }
+
+func ticketFormImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.TicketForm] {
+	return models.ImportLookup[zendesk.TicketForm]{
+		List: func(ctx context.Context) ([]zendesk.TicketForm, error) {
+			return listAll[zendesk.TicketForm](ctx, zdClient, "/ticket_forms.json", "ticket_forms")
+		},
+		ID: func(form zendesk.TicketForm) string { return formatID(form.ID) },
+		Attributes: map[string]func(zendesk.TicketForm) string{
+			"name": func(form zendesk.TicketForm) string { return form.Name },
+		},
+	}
+}
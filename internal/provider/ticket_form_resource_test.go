@@ -203,4 +203,47 @@ func TestAccTicketForm(t *testing.T) {
 			},
 		})
 	})
+
+	t.Run("ticket form restricted to brands", func(t *testing.T) {
+		t.Parallel()
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: config.Variables{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyTicketFormResourceName,
+							tfjsonpath.New("in_all_brands"),
+							knownvalue.Bool(false)),
+						statecheck.ExpectKnownValue(
+							dummyTicketFormResourceName,
+							tfjsonpath.New("restricted_brand_ids"),
+							knownvalue.SetSizeExact(1)),
+					},
+				},
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: config.Variables{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyTicketFormResourceName,
+							tfjsonpath.New("in_all_brands"),
+							knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue(
+							dummyTicketFormResourceName,
+							tfjsonpath.New("restricted_brand_ids"),
+							knownvalue.Null()),
+					},
+				},
+			},
+		})
+	})
 }
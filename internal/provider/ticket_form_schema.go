@@ -2,6 +2,7 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -79,9 +80,29 @@ but the total fields are 6+, terraform will throw an error trying to create the
 			Description: "Is the form the default form for this account",
 		},
 		"position": schema.Int64Attribute{
+			Optional: true,
+			Computed: true,
+			Description: "The position of this form among other forms in the account, i.e. dropdown. " +
+				"Use zendesk_ticket_form_order instead to order several forms",
+		},
+		"in_all_brands": schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+			Description: "Is the form available in all brands of the account. " +
+				"Forms restricted to brands with restricted_brand_ids are not, it can only be false when restricted_brand_ids is set",
+			Validators: []validator.Bool{
+				InAllBrandsValidator{},
+			},
+		},
+		"restricted_brand_ids": schema.SetAttribute{
 			Optional:    true,
 			Computed:    true,
-			Description: "The position of this form among other forms in the account, i.e. dropdown",
+			ElementType: types.Int64Type,
+			Description: "IDs of the brands the form is restricted to, it can't be set when in_all_brands is true",
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				RestrictedBrandsValidator{},
+			},
 		},
 		"created_at": schema.StringAttribute{
 			Description: "The time the ticket form was created.",
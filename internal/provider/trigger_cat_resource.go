@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/JacobPotter/go-zendesk/zendesk"
 	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -12,7 +13,7 @@ var _ resource.Resource = &TriggerCategoryResource{}
 var _ resource.ResourceWithImportState = &TriggerCategoryResource{}
 
 type TriggerCategoryResource struct {
-	client *zendesk.Client
+	client *zendeskapi.Client
 }
 
 func NewTriggerCategoryResource() resource.Resource {
@@ -45,12 +46,20 @@ func (t *TriggerCategoryResource) Configure(ctx context.Context, req resource.Co
 		return
 	}
 
-	t.client = client
+	t.client = zendeskapi.NewClient(client)
 }
 
-// Create implements resource.Resource.
+// Create implements resource.Resource. A category without a configured position is created
+// without one, so it is added last rather than moved first.
 func (t *TriggerCategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	models.CreateResource(ctx, req, resp, &models.TriggerCategoryResourceModel{}, t.client.CreateTriggerCategory)
+	data := &models.TriggerCategoryResourceModel{}
+	models.CreateResource(ctx, req, resp, data, func(ctx context.Context, category zendesk.TriggerCategory) (zendesk.TriggerCategory, error) {
+		if data.Position.IsUnknown() || data.Position.IsNull() {
+			return t.client.CreateTriggerCategoryWithoutPosition(ctx, category.Name)
+		}
+
+		return t.client.CreateTriggerCategory(ctx, category)
+	})
 }
 
 // Read implements resource.Resource.
@@ -58,9 +67,17 @@ func (t *TriggerCategoryResource) Read(ctx context.Context, req resource.ReadReq
 	models.ReadResource(ctx, req, resp, &models.TriggerCategoryResourceModel{}, t.client.GetTriggerCategory)
 }
 
-// Update implements resource.Resource.
+// Update implements resource.Resource. A category without a configured position is only
+// renamed, so it keeps the position set by zendesk_trigger_order.
 func (t *TriggerCategoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	models.UpdateResource(ctx, req, resp, &models.TriggerCategoryResourceModel{}, t.client.UpdateTriggerCategory)
+	data := &models.TriggerCategoryResourceModel{}
+	models.UpdateResource(ctx, req, resp, data, func(ctx context.Context, id int64, category zendesk.TriggerCategory) (zendesk.TriggerCategory, error) {
+		if data.Position.IsUnknown() || data.Position.IsNull() {
+			return t.client.UpdateTriggerCategoryName(ctx, id, category.Name)
+		}
+
+		return t.client.UpdateTriggerCategory(ctx, id, category)
+	})
 }
 
 // Delete implements resource.Resource.
@@ -70,5 +87,17 @@ func (t *TriggerCategoryResource) Delete(ctx context.Context, req resource.Delet
 
 // ImportState implements resource.ResourceWithImportState.
 func (t *TriggerCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	models.ImportResource(ctx, req, resp, &models.TriggerCategoryResourceModel{}, t.client.GetTriggerCategory)
+	models.ImportResource(ctx, req, resp, &models.TriggerCategoryResourceModel{}, t.client.GetTriggerCategory, triggerCategoryImportLookup(t.client.Client))
+}
+
+func triggerCategoryImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.TriggerCategory] {
+	return models.ImportLookup[zendesk.TriggerCategory]{
+		List: func(ctx context.Context) ([]zendesk.TriggerCategory, error) {
+			return listAll[zendesk.TriggerCategory](ctx, zdClient, "/trigger_categories.json", "trigger_categories")
+		},
+		ID: func(category zendesk.TriggerCategory) string { return category.ID },
+		Attributes: map[string]func(zendesk.TriggerCategory) string{
+			"name": func(category zendesk.TriggerCategory) string { return category.Name },
+		},
+	}
 }
@@ -2,10 +2,16 @@ package provider
 
 import (
 	"fmt"
+	"io"
+	"net/http"
 	"strconv"
 	"testing"
 
 	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -87,3 +93,105 @@ resource "zendesk_trigger_category" "test" {
 		name,
 	)
 }
+
+func TestTriggerCategoryResource_Create(t *testing.T) {
+	cases := []struct {
+		testName     string
+		position     tftypes.Value
+		expectedBody string
+	}{
+		{
+			testName:     "should not send a position when it isn't configured",
+			position:     tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			expectedBody: `{"trigger_category":{"name":"Notifications"}}`,
+		},
+		{
+			testName:     "should send a configured position",
+			position:     tftypes.NewValue(tftypes.Number, 2),
+			expectedBody: `{"trigger_category":{"id":"","created_at":"0001-01-01T00:00:00Z","name":"Notifications","position":2,"updated_at":"0001-01-01T00:00:00Z"}}`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			var body string
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				reqBody, _ := io.ReadAll(r.Body)
+				body = string(reqBody)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"trigger_category":{"id":"10001","name":"Notifications","position":2}}`))
+			})
+			r := &TriggerCategoryResource{client: zendeskapi.NewClient(testZendeskClient(t, handler))}
+
+			plan := testResourceState(t, TriggerCategorySchema, map[string]tftypes.Value{
+				"name":     tftypes.NewValue(tftypes.String, "Notifications"),
+				"position": c.position,
+			})
+			response := &fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+
+			r.Create(t.Context(), fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %+v", response.Diagnostics)
+			}
+
+			if body != c.expectedBody {
+				t.Fatalf("expected body %s, got %s", c.expectedBody, body)
+			}
+		})
+	}
+}
+
+func TestTriggerCategoryResource_Update(t *testing.T) {
+	cases := []struct {
+		testName     string
+		position     tftypes.Value
+		expectedBody string
+	}{
+		{
+			testName:     "should only rename a category without a configured position",
+			position:     tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			expectedBody: `{"trigger_category":{"name":"Notifications"}}`,
+		},
+		{
+			testName:     "should send a configured position",
+			position:     tftypes.NewValue(tftypes.Number, 2),
+			expectedBody: `{"trigger_category":{"id":"","created_at":"0001-01-01T00:00:00Z","name":"Notifications","position":2,"updated_at":"0001-01-01T00:00:00Z"}}`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			var body string
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				reqBody, _ := io.ReadAll(r.Body)
+				body = string(reqBody)
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"trigger_category":{"id":"10001","name":"Notifications","position":2}}`))
+			})
+			r := &TriggerCategoryResource{client: zendeskapi.NewClient(testZendeskClient(t, handler))}
+
+			plan := testResourceState(t, TriggerCategorySchema, map[string]tftypes.Value{
+				"id":       tftypes.NewValue(tftypes.Number, 10001),
+				"name":     tftypes.NewValue(tftypes.String, "Notifications"),
+				"position": c.position,
+			})
+			response := &fwresource.UpdateResponse{State: plan}
+
+			r.Update(t.Context(), fwresource.UpdateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, State: plan}, response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %+v", response.Diagnostics)
+			}
+
+			if body != c.expectedBody {
+				t.Fatalf("expected body %s, got %s", c.expectedBody, body)
+			}
+		})
+	}
+}
@@ -18,9 +18,10 @@ var TriggerCategorySchema = schema.Schema{
 			Required: true,
 		},
 		"position": schema.Int64Attribute{
-			Optional:    true,
-			Computed:    true,
-			Description: "The relative position of the ticket field on a ticket. Note that for accounts with ticket forms, positions are controlled by the different forms",
+			Optional: true,
+			Computed: true,
+			Description: "The position of the category among the other trigger categories. " +
+				"Leave it unset when the order is managed by zendesk_trigger_order",
 		},
 		"created_at": schema.StringAttribute{
 			Description: "The time the macro was created.",
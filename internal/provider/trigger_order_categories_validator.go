@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TriggerOrderCategoriesValidator checks a category is listed once and a trigger is listed
+// under a single category, the batch job would otherwise get the same item twice.
+type TriggerOrderCategoriesValidator struct{}
+
+var _ validator.List = TriggerOrderCategoriesValidator{}
+
+func (v TriggerOrderCategoriesValidator) Description(_ context.Context) string {
+	return "Categories must be listed once, triggers must be listed under a single category"
+}
+
+func (v TriggerOrderCategoriesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v TriggerOrderCategoriesValidator) ValidateList(_ context.Context, request validator.ListRequest, response *validator.ListResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	categoryIndexes := map[int64]int{}
+	triggerIndexes := map[int64]int{}
+
+	for i, element := range request.ConfigValue.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsNull() || object.IsUnknown() {
+			continue
+		}
+
+		attributes := object.Attributes()
+
+		if categoryID, ok := attributes["category_id"].(types.Int64); ok && !categoryID.IsNull() && !categoryID.IsUnknown() {
+			if first, listed := categoryIndexes[categoryID.ValueInt64()]; listed {
+				response.Diagnostics.AddAttributeError(
+					request.Path.AtListIndex(i).AtName("category_id"),
+					"Invalid trigger order",
+					fmt.Sprintf("Category %d is already listed at index %d", categoryID.ValueInt64(), first),
+				)
+			} else {
+				categoryIndexes[categoryID.ValueInt64()] = i
+			}
+		}
+
+		triggerIDs, ok := attributes["trigger_ids"].(types.List)
+		if !ok || triggerIDs.IsNull() || triggerIDs.IsUnknown() {
+			continue
+		}
+
+		for _, triggerElement := range triggerIDs.Elements() {
+			triggerID, ok := triggerElement.(types.Int64)
+			if !ok || triggerID.IsNull() || triggerID.IsUnknown() {
+				continue
+			}
+
+			// duplicates inside a category are reported by the trigger_ids validator
+			if first, listed := triggerIndexes[triggerID.ValueInt64()]; listed && first != i {
+				response.Diagnostics.AddAttributeError(
+					request.Path.AtListIndex(i).AtName("trigger_ids"),
+					"Invalid trigger order",
+					fmt.Sprintf("Trigger %d is already listed under the category at index %d", triggerID.ValueInt64(), first),
+				)
+			} else if !listed {
+				triggerIndexes[triggerID.ValueInt64()] = i
+			}
+		}
+	}
+}
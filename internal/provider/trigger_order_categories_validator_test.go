@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTriggerOrderCategoriesValidator(t *testing.T) {
+	categoryType := map[string]attr.Type{
+		"category_id": types.Int64Type,
+		"trigger_ids": types.ListType{ElemType: types.Int64Type},
+	}
+
+	category := func(categoryID int64, triggerIDs ...int64) attr.Value {
+		ids := make([]attr.Value, 0, len(triggerIDs))
+		for _, id := range triggerIDs {
+			ids = append(ids, types.Int64Value(id))
+		}
+
+		return types.ObjectValueMust(categoryType, map[string]attr.Value{
+			"category_id": types.Int64Value(categoryID),
+			"trigger_ids": types.ListValueMust(types.Int64Type, ids),
+		})
+	}
+
+	cases := []struct {
+		testName    string
+		categories  []attr.Value
+		expectError bool
+	}{
+		{
+			testName:   "should accept distinct categories and triggers",
+			categories: []attr.Value{category(1, 10, 20), category(2, 30)},
+		},
+		{
+			testName:    "should reject a category listed twice",
+			categories:  []attr.Value{category(1, 10), category(1, 20)},
+			expectError: true,
+		},
+		{
+			testName:    "should reject a trigger listed under two categories",
+			categories:  []attr.Value{category(1, 10), category(2, 10)},
+			expectError: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			request := validator.ListRequest{
+				Path:        path.Root("categories"),
+				ConfigValue: types.ListValueMust(types.ObjectType{AttrTypes: categoryType}, c.categories),
+			}
+			response := &validator.ListResponse{}
+
+			TriggerOrderCategoriesValidator{}.ValidateList(t.Context(), request, response)
+
+			if response.Diagnostics.HasError() != c.expectError {
+				t.Fatalf("expected error %t, got diagnostics: %+v", c.expectError, response.Diagnostics)
+			}
+		})
+	}
+}
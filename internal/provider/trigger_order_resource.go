@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.ResourceWithImportState = &TriggerOrderResource{}
+var _ resource.ResourceWithConfigure = &TriggerOrderResource{}
+
+// TriggerOrderResource manages the order of the trigger categories and their triggers, there is
+// one per account.
+type TriggerOrderResource struct {
+	client *zendeskapi.Client
+}
+
+func NewTriggerOrderResource() resource.Resource {
+	return &TriggerOrderResource{}
+}
+
+func (o *TriggerOrderResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_trigger_order"
+}
+
+func (o *TriggerOrderResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*zendesk.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendesk.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	o.client = zendeskapi.NewClient(client)
+}
+
+func (o *TriggerOrderResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = TriggerOrderSchema
+}
+
+func (o *TriggerOrderResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data models.TriggerOrderResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	listed, diags := data.GetApiModelFromTfModel(ctx)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := o.reorder(ctx, listed)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error reordering triggers", err, request.Plan.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, order)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (o *TriggerOrderResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data models.TriggerOrderResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := o.currentOrder(ctx)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error reading triggers", err, request.State.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, order)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (o *TriggerOrderResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data models.TriggerOrderResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	listed, diags := data.GetApiModelFromTfModel(ctx)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := o.reorder(ctx, listed)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error reordering triggers", err, request.Plan.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, order)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the resource from the state, the categories and triggers keep their
+// current order.
+func (o *TriggerOrderResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+}
+
+// ImportState imports the order of every trigger category and trigger of the account with the
+// ID trigger_order.
+func (o *TriggerOrderResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	if request.ID != models.TriggerOrderID {
+		response.Diagnostics.AddError("Invalid import id", fmt.Sprintf("the trigger order is imported with the id %s, got %s", models.TriggerOrderID, request.ID))
+		return
+	}
+
+	order, err := o.currentOrder(ctx)
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error importing resource", err, response.State.Schema)...)
+		return
+	}
+
+	var data models.TriggerOrderResourceModel
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, order)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// currentOrder returns every trigger category and trigger in their current order.
+func (o *TriggerOrderResource) currentOrder(ctx context.Context) ([]models.TriggerCategoryOrder, error) {
+	categories, err := listAll[zendesk.TriggerCategory](ctx, o.client.Client, "/trigger_categories.json", "trigger_categories")
+	if err != nil {
+		return nil, err
+	}
+
+	triggers, err := listAll[zendesk.Trigger](ctx, o.client.Client, "/triggers.json", "triggers")
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewTriggerOrder(categories, triggers), nil
+}
+
+// reorder sends the position of every category and trigger in one batch job, then reads them
+// again to get their new order.
+func (o *TriggerOrderResource) reorder(ctx context.Context, listed []models.TriggerCategoryOrder) ([]models.TriggerCategoryOrder, error) {
+	current, err := o.currentOrder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.client.RunTriggerCategoryJob(ctx, triggerCategoryJobItems(completeTriggerOrder(listed, current)))
+	if err != nil {
+		return nil, err
+	}
+
+	return o.currentOrder(ctx)
+}
+
+// completeTriggerOrder appends the categories not listed after the listed ones, and the
+// triggers not listed after the listed triggers of their category, in their current order.
+func completeTriggerOrder(listed, current []models.TriggerCategoryOrder) []models.TriggerCategoryOrder {
+	listedCategoryIDs := make([]int64, 0, len(listed))
+	listedTriggerIDs := map[int64][]int64{}
+	var allListedTriggerIDs []int64
+
+	for _, category := range listed {
+		listedCategoryIDs = append(listedCategoryIDs, category.CategoryID)
+		listedTriggerIDs[category.CategoryID] = category.TriggerIDs
+		allListedTriggerIDs = append(allListedTriggerIDs, category.TriggerIDs...)
+	}
+
+	currentCategoryIDs := make([]int64, 0, len(current))
+	currentTriggerIDs := map[int64][]int64{}
+
+	for _, category := range current {
+		currentCategoryIDs = append(currentCategoryIDs, category.CategoryID)
+		currentTriggerIDs[category.CategoryID] = category.TriggerIDs
+	}
+
+	order := make([]models.TriggerCategoryOrder, 0, len(current))
+
+	for _, categoryID := range completeOrder(listedCategoryIDs, currentCategoryIDs) {
+		triggerIDs := slices.Clone(listedTriggerIDs[categoryID])
+
+		for _, triggerID := range currentTriggerIDs[categoryID] {
+			if !slices.Contains(allListedTriggerIDs, triggerID) {
+				triggerIDs = append(triggerIDs, triggerID)
+			}
+		}
+
+		order = append(order, models.TriggerCategoryOrder{CategoryID: categoryID, TriggerIDs: triggerIDs})
+	}
+
+	return order
+}
+
+// triggerCategoryJobItems numbers the categories in order, and the triggers in order across
+// categories.
+func triggerCategoryJobItems(order []models.TriggerCategoryOrder) zendeskapi.TriggerCategoryJobItems {
+	items := zendeskapi.TriggerCategoryJobItems{}
+	var triggerPosition int64
+
+	for categoryPosition, category := range order {
+		categoryID := strconv.FormatInt(category.CategoryID, 10)
+
+		items.TriggerCategories = append(items.TriggerCategories, zendeskapi.TriggerCategoryJobItem{
+			ID:       categoryID,
+			Position: int64(categoryPosition),
+		})
+
+		for _, triggerID := range category.TriggerIDs {
+			items.Triggers = append(items.Triggers, zendeskapi.TriggerCategoryJobItem{
+				ID:         strconv.FormatInt(triggerID, 10),
+				Position:   triggerPosition,
+				CategoryID: categoryID,
+			})
+			triggerPosition++
+		}
+	}
+
+	return items
+}
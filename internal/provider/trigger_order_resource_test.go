@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+)
+
+var dummyTriggerOrderResourceName = "zendesk_trigger_order.test"
+
+func TestAccTriggerOrder(t *testing.T) {
+	t.Run("reorder triggers", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: config.Variables{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.CompareValuePairs(
+							dummyTriggerOrderResourceName,
+							tfjsonpath.New("categories").AtSliceIndex(0).AtMapKey("trigger_ids").AtSliceIndex(0),
+							"zendesk_trigger.first",
+							tfjsonpath.New("id"),
+							compare.ValuesSame(),
+						),
+					},
+				},
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: config.Variables{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.CompareValuePairs(
+							dummyTriggerOrderResourceName,
+							tfjsonpath.New("categories").AtSliceIndex(0).AtMapKey("category_id"),
+							"zendesk_trigger_category.second",
+							tfjsonpath.New("id"),
+							compare.ValuesSame(),
+						),
+						statecheck.CompareValuePairs(
+							dummyTriggerOrderResourceName,
+							tfjsonpath.New("categories").AtSliceIndex(1).AtMapKey("trigger_ids").AtSliceIndex(0),
+							"zendesk_trigger.second",
+							tfjsonpath.New("id"),
+							compare.ValuesSame(),
+						),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestTriggerOrderResource_Update(t *testing.T) {
+	var jobBody string
+
+	categories := `{"trigger_categories":[{"id":"1","position":0},{"id":"2","position":1},{"id":"3","position":2}],"meta":{"has_more":false}}`
+	triggers := `{"triggers":[{"id":10,"position":0,"category_id":"1"},{"id":20,"position":1,"category_id":"1"},{"id":30,"position":2,"category_id":"2"}],"meta":{"has_more":false}}`
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v2/trigger_categories.json":
+			_, _ = w.Write([]byte(categories))
+		case "/api/v2/triggers.json":
+			_, _ = w.Write([]byte(triggers))
+		case "/api/v2/trigger_categories/jobs.json":
+			body, _ := io.ReadAll(r.Body)
+			jobBody = string(body)
+			categories = `{"trigger_categories":[{"id":"2","position":0},{"id":"1","position":1},{"id":"3","position":2}],"meta":{"has_more":false}}`
+			triggers = `{"triggers":[{"id":30,"position":0,"category_id":"2"},{"id":20,"position":1,"category_id":"2"},{"id":10,"position":2,"category_id":"1"}],"meta":{"has_more":false}}`
+			_, _ = w.Write([]byte(`{"job":{"status":"completed","results":{}}}`))
+		}
+	})
+	r := &TriggerOrderResource{client: zendeskapi.NewClient(testZendeskClient(t, handler))}
+
+	categoryType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"category_id": tftypes.Number,
+		"trigger_ids": tftypes.List{ElementType: tftypes.Number},
+	}}
+	category := func(id int64, triggerIDs ...int64) tftypes.Value {
+		ids := make([]tftypes.Value, 0, len(triggerIDs))
+		for _, triggerID := range triggerIDs {
+			ids = append(ids, tftypes.NewValue(tftypes.Number, triggerID))
+		}
+
+		return tftypes.NewValue(categoryType, map[string]tftypes.Value{
+			"category_id": tftypes.NewValue(tftypes.Number, id),
+			"trigger_ids": tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, ids),
+		})
+	}
+
+	// trigger 20 moves from category 1 to category 2, trigger 10 is not listed
+	plan := testResourceState(t, TriggerOrderSchema, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "trigger_order"),
+		"categories": tftypes.NewValue(tftypes.List{ElementType: categoryType}, []tftypes.Value{
+			category(2, 30, 20),
+		}),
+	})
+	response := &fwresource.UpdateResponse{State: plan}
+
+	r.Update(t.Context(), fwresource.UpdateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, State: plan}, response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", response.Diagnostics)
+	}
+
+	expectedJob := `{"job":{"action":"patch","items":{` +
+		`"trigger_categories":[{"id":"2","position":0},{"id":"1","position":1},{"id":"3","position":2}],` +
+		`"triggers":[{"id":"30","position":0,"category_id":"2"},{"id":"20","position":1,"category_id":"2"},{"id":"10","position":2,"category_id":"1"}]}}}`
+	if jobBody != expectedJob {
+		t.Fatalf("expected job %s, got %s", expectedJob, jobBody)
+	}
+
+	var categoriesState []models.TriggerOrderCategoryResourceModel
+	response.Diagnostics.Append(response.State.GetAttribute(t.Context(), path.Root("categories"), &categoriesState)...)
+
+	if len(categoriesState) != 1 || categoriesState[0].CategoryID.ValueInt64() != 2 || len(categoriesState[0].TriggerIDs.Elements()) != 2 {
+		t.Fatalf("unexpected categories %+v", categoriesState)
+	}
+}
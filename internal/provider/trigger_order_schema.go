@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var TriggerOrderSchema = schema.Schema{
+	Version: 0,
+	MarkdownDescription: "Order of the trigger categories and of the triggers inside each category, set with a single batch job. " +
+		"The listed categories and triggers come first, the others keep their relative order after them. " +
+		"Triggers listed under another category are moved to it, keep `category_id` of the `zendesk_trigger` resources in sync. " +
+		"Don't set `position` on the `zendesk_trigger_category` and `zendesk_trigger` resources ordered here. " +
+		"Destroying the resource keeps the current order. " +
+		"See [Trigger Categories Batch Jobs](https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#create-batch-job-for-trigger-categories) for more information.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the trigger order, always trigger_order",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"categories": schema.ListNestedAttribute{
+			Required:    true,
+			Description: "Trigger categories in the order they are run. A category is listed once, and a trigger under a single category",
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				TriggerOrderCategoriesValidator{},
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"category_id": schema.Int64Attribute{
+						Required:    true,
+						Description: "ID of the trigger category",
+					},
+					"trigger_ids": schema.ListAttribute{
+						Required:    true,
+						ElementType: types.Int64Type,
+						Description: "IDs of the triggers of the category in the order they are run",
+						Validators: []validator.List{
+							listvalidator.UniqueValues(),
+						},
+					},
+				},
+			},
+		},
+	},
+}
@@ -71,7 +71,7 @@ func (t *TriggerResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 // ImportState implements resource.ResourceWithImportState.
 func (t *TriggerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	models.ImportResource(ctx, req, resp, &models.TriggerResourceModel{}, t.client.GetTrigger)
+	models.ImportResource(ctx, req, resp, &models.TriggerResourceModel{}, t.client.GetTrigger, triggerImportLookup(t.client))
 }
 
 func (t *TriggerResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
@@ -112,3 +112,15 @@ func (t *TriggerResource) UpgradeState(ctx context.Context) map[int64]resource.S
 		},
 	}
 }
+
+func triggerImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.Trigger] {
+	return models.ImportLookup[zendesk.Trigger]{
+		List: func(ctx context.Context) ([]zendesk.Trigger, error) {
+			return listAll[zendesk.Trigger](ctx, zdClient, "/triggers.json", "triggers")
+		},
+		ID: func(trigger zendesk.Trigger) string { return formatID(trigger.ID) },
+		Attributes: map[string]func(zendesk.Trigger) string{
+			"title": func(trigger zendesk.Trigger) string { return trigger.Title },
+		},
+	}
+}
@@ -46,9 +46,10 @@ var TriggerSchema = schema.Schema{
 			Default:     booldefault.StaticBool(true),
 		},
 		"position": schema.Int64Attribute{
-			Optional:    true,
-			Computed:    true,
-			Description: "The relative position of the ticket field on a ticket. Note that for accounts with ticket forms, positions are controlled by the different forms",
+			Optional: true,
+			Computed: true,
+			Description: "The position of the trigger among the other triggers of its category. " +
+				"Leave it unset when the order is managed by zendesk_trigger_order",
 		},
 		"description": schema.StringAttribute{
 			Description: "The description of the trigger.",
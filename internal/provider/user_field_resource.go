@@ -76,5 +76,18 @@ func (r *UserFieldResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *UserFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	models.ImportResource(ctx, req, resp, &models.UserFieldResourceModel{}, r.client.GetUserField)
+	models.ImportResource(ctx, req, resp, &models.UserFieldResourceModel{}, r.client.GetUserField, userFieldImportLookup(r.client))
+}
+
+func userFieldImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.UserField] {
+	return models.ImportLookup[zendesk.UserField]{
+		List: func(ctx context.Context) ([]zendesk.UserField, error) {
+			return listAll[zendesk.UserField](ctx, zdClient, "/user_fields.json", "user_fields")
+		},
+		ID: func(field zendesk.UserField) string { return formatID(field.ID) },
+		Attributes: map[string]func(zendesk.UserField) string{
+			"key":   func(field zendesk.UserField) string { return field.Key },
+			"title": func(field zendesk.UserField) string { return field.Title },
+		},
+	}
 }
@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 func GetUserOrgFieldSchema(fieldType string) schema.Schema {
@@ -131,6 +132,9 @@ in Zendesk help and [Conditions reference](https://developer.zendesk.com/documen
 				Description: "A representation of what type of object the field references. " +
 					"Options are \"zen:user\", \"zen:organization\", \"zen:ticket\", and \"zen:custom_object:{key}\" where " +
 					"key is a custom object key. For example \"zen:custom_object:apartment\".",
+				Validators: []validator.String{
+					RelationshipTargetTypeValidator{},
+				},
 			},
 			"system": schema.BoolAttribute{
 				Computed:    true,
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithConfigure = &UserResource{}
+
+type UserResource struct {
+	client *zendeskapi.Client
+}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+func (u *UserResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*zendesk.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendesk.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	u.client = zendeskapi.NewClient(client)
+}
+
+func (u *UserResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_user"
+}
+
+func (u *UserResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = UserSchema
+}
+
+func (u *UserResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	models.CreateResource(ctx, request, response, &models.UserResourceModel{}, u.client.CreateUser)
+}
+
+// Read removes deleted users from the state, Zendesk keeps returning them as inactive users
+// until they are permanently deleted.
+func (u *UserResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	data := &models.UserResourceModel{}
+
+	response.Diagnostics.Append(request.State.Get(ctx, data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := u.client.GetUser(ctx, data.GetID())
+
+	if models.IsNotFound(err) || (err == nil && !user.Active) {
+		tflog.Warn(ctx, "User not found or deleted, removing from state", map[string]any{"id": data.GetID()})
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error reading resource", err, request.State.Schema)...)
+		return
+	}
+
+	response.Diagnostics.Append(data.GetTfModelFromApiModel(ctx, user)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (u *UserResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	models.UpdateResource(ctx, request, response, &models.UserResourceModel{}, u.client.UpdateUser)
+}
+
+// Delete suspends or deletes the user, depending on its destroy action.
+func (u *UserResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	data := &models.UserResourceModel{}
+
+	response.Diagnostics.Append(request.State.Get(ctx, data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	destroyUser := u.client.SuspendUser
+	if data.DestroyAction.ValueString() == models.UserDestroyActionDelete {
+		destroyUser = u.client.DeleteUser
+	}
+
+	models.DeleteResource[zendesk.User](ctx, request, response, data, destroyUser)
+}
+
+func (u *UserResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	models.ImportResource(ctx, request, response, &models.UserResourceModel{}, u.client.GetUser, userImportLookup(u.client.Client))
+}
+
+// userImportLookup only lists agents and admins, end users are not managed by the provider.
+func userImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.User] {
+	return models.ImportLookup[zendesk.User]{
+		List: func(ctx context.Context) ([]zendesk.User, error) {
+			return listAll[zendesk.User](ctx, zdClient, "/users.json?role[]=agent&role[]=admin", "users")
+		},
+		ID: func(user zendesk.User) string { return formatID(user.ID) },
+		Attributes: map[string]func(zendesk.User) string{
+			"email": func(user zendesk.User) string { return user.Email },
+		},
+	}
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+)
+
+var dummyUserResourceName = "zendesk_user.test"
+
+func TestAccUser(t *testing.T) {
+	t.Parallel()
+
+	t.Run("basic_user", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyUserResourceName,
+							tfjsonpath.New("role"),
+							knownvalue.StringExact("agent"),
+						),
+						statecheck.ExpectKnownValue(
+							dummyUserResourceName,
+							tfjsonpath.New("time_zone"),
+							knownvalue.StringExact("Eastern Time (US & Canada)"),
+						),
+					},
+				},
+				{
+					ResourceName: dummyUserResourceName,
+					ImportState:  true,
+					ImportStateIdFunc: func(*terraform.State) (string, error) {
+						return "email:" + strings.ToLower(fullResourceName) + "@example.com", nil
+					},
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"destroy_action"},
+					ConfigFile:              config.TestNameFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("update_user", func(t *testing.T) {
+		fullResourceName := fmt.Sprintf("tf_acc_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyUserResourceName,
+							tfjsonpath.New("role"),
+							knownvalue.StringExact("agent"),
+						),
+					},
+				},
+				{
+					ConfigFile: config.TestStepFile("main.tf"),
+					ConfigVariables: map[string]config.Variable{
+						"title": config.StringVariable(fullResourceName),
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							dummyUserResourceName,
+							tfjsonpath.New("role"),
+							knownvalue.StringExact("admin"),
+						),
+						statecheck.ExpectKnownValue(
+							dummyUserResourceName,
+							tfjsonpath.New("name"),
+							knownvalue.StringExact(fullResourceName+" updated"),
+						),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestUserResource_ReadDeleted(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"user":{"id":123,"name":"Deleted agent","active":false}}`))
+	})
+	r := &UserResource{client: zendeskapi.NewClient(testZendeskClient(t, handler))}
+
+	state := testResourceState(t, UserSchema, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.Number, 123),
+	})
+	response := &fwresource.ReadResponse{State: state}
+
+	r.Read(t.Context(), fwresource.ReadRequest{State: state}, response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", response.Diagnostics)
+	}
+
+	if !response.State.Raw.IsNull() {
+		t.Fatalf("expected resource to be removed from state, got %s", response.State.Raw)
+	}
+}
+
+func TestUserResource_Delete(t *testing.T) {
+	cases := []struct {
+		testName       string
+		destroyAction  string
+		expectedMethod string
+	}{
+		{testName: "should suspend the user", destroyAction: "suspend", expectedMethod: http.MethodPut},
+		{testName: "should delete the user", destroyAction: "delete", expectedMethod: http.MethodDelete},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			var method, path string
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				method, path = r.Method, r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"user":{"id":123}}`))
+			})
+			r := &UserResource{client: zendeskapi.NewClient(testZendeskClient(t, handler))}
+
+			state := testResourceState(t, UserSchema, map[string]tftypes.Value{
+				"id":             tftypes.NewValue(tftypes.Number, 123),
+				"destroy_action": tftypes.NewValue(tftypes.String, c.destroyAction),
+			})
+			response := &fwresource.DeleteResponse{State: state}
+
+			r.Delete(t.Context(), fwresource.DeleteRequest{State: state}, response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %+v", response.Diagnostics)
+			}
+
+			if method != c.expectedMethod || path != "/api/v2/users/123.json" {
+				t.Fatalf("expected %s /api/v2/users/123.json, got %s %s", c.expectedMethod, method, path)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var UserSchema = schema.Schema{
+	Version: 0,
+	MarkdownDescription: "Agent or admin user of Zendesk, " +
+		"see [Documentation](https://developer.zendesk.com/api-reference/ticketing/users/users/) " +
+		"for more information on configuration",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the user",
+		},
+		"email": schema.StringAttribute{
+			Required:    true,
+			Description: "The primary email address of the user. Changing the email will recreate the resource.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"role": schema.StringAttribute{
+			Required:    true,
+			Description: "The role of the user. Allowed values are \"agent\" or \"admin\".",
+			Validators: []validator.String{
+				stringvalidator.OneOf("agent", "admin"),
+			},
+		},
+		"custom_role_id": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The custom role of the agent, on Enterprise plans. Zendesk assigns a default one when it isn't set",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"default_group_id": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The default group of the agent, Zendesk assigns the default group of the account when it isn't set",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"organization_id": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The organization of the user",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"time_zone": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "The time zone of the user, ex: `Eastern Time (US & Canada)`, see [Time Zones](https://developer.zendesk.com/api-reference/introduction/data-types/#time-zones). Defaults to the time zone of the account",
+			Validators: []validator.String{
+				TimeZoneValidator{},
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"locale": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The locale of the user, ex: en-US. Defaults to the locale of the account",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"tags": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Description: "The tags of the user. Zendesk stores tags in lowercase, with spaces replaced by underscores",
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"user_fields": schema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Description: "Values of the user fields keyed by field key, ex: { team = \"billing\" }. " +
+				"Checkbox values are \"true\" or \"false\" and date values are formatted as YYYY-MM-DD. " +
+				"Only the fields set here are managed, removing a field from the map leaves its value unchanged in Zendesk",
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"suspended": schema.BoolAttribute{
+			Computed:    true,
+			Description: "If the user is suspended",
+		},
+		"destroy_action": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Description: "What destroying the resource does to the user in Zendesk. Allowed values are \"suspend\", which keeps the user and its history, " +
+				"or \"delete\". A suspended user keeps its email address, so a new user with the same email can't be created. Default value for provider is suspend",
+			Default: stringdefault.StaticString(models.UserDestroyActionSuspend),
+			Validators: []validator.String{
+				stringvalidator.OneOf(models.UserDestroyActionSuspend, models.UserDestroyActionDelete),
+			},
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The time the user was created.",
+			Computed:    true,
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The time of the last update of the user.",
+			Computed:    true,
+		},
+		"url": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Description: "The URL for this resource",
+		},
+	},
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/zendeskapi"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// NewViewOrderResource manages the order of the views, there is one per account.
+func NewViewOrderResource() resource.Resource {
+	return &orderResource[zendesk.View, int64]{
+		typeName:  "_view_order",
+		id:        models.ViewOrderID,
+		attribute: "view_ids",
+		items:     "views",
+		schema:    ViewOrderSchema,
+		list: func(ctx context.Context, client *zendeskapi.Client) ([]zendesk.View, error) {
+			return listAll[zendesk.View](ctx, client.Client, "/views.json", "views")
+		},
+		currentOrder: models.ViewIDsByPosition,
+		update: func(ctx context.Context, client *zendeskapi.Client, ids []int64) error {
+			return client.UpdateViewPositions(ctx, ids)
+		},
+	}
+}
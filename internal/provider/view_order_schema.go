@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var ViewOrderSchema = schema.Schema{
+	Version: 0,
+	MarkdownDescription: "Order of the views of the account, set with a single bulk update. " +
+		"The listed views come first, views not listed keep their relative order after them. " +
+		"Don't set `position` on the `zendesk_view` resources ordered here. " +
+		"Destroying the resource keeps the current order. " +
+		"See [Update Many Views](https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#update-many-views) for more information.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the view order, always view_order",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"view_ids": schema.ListAttribute{
+			Required:    true,
+			ElementType: types.Int64Type,
+			Description: "IDs of the views in the order they are shown",
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+			},
+		},
+	},
+}
@@ -76,7 +76,7 @@ func (v *ViewResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 // ImportState implements resource.ResourceWithImportState.
 func (v *ViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	models.ImportResource(ctx, req, resp, &models.ViewResourceModel{}, v.client.GetView)
+	models.ImportResource(ctx, req, resp, &models.ViewResourceModel{}, v.client.GetView, viewImportLookup(v.client))
 }
 
 func (v *ViewResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
@@ -153,3 +153,15 @@ func (v *ViewResource) UpgradeState(ctx context.Context) map[int64]resource.Stat
 		},
 	}
 }
+
+func viewImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.View] {
+	return models.ImportLookup[zendesk.View]{
+		List: func(ctx context.Context) ([]zendesk.View, error) {
+			return listAll[zendesk.View](ctx, zdClient, "/views.json", "views")
+		},
+		ID: func(view zendesk.View) string { return formatID(view.ID) },
+		Attributes: map[string]func(zendesk.View) string{
+			"title": func(view zendesk.View) string { return view.Title },
+		},
+	}
+}
@@ -106,9 +106,10 @@ var ViewSchema = schema.Schema{
 			Computed:    true,
 		},
 		"position": schema.Int64Attribute{
-			Optional:    true,
-			Computed:    true,
-			Description: "The relative position of the view",
+			Optional: true,
+			Computed: true,
+			Description: "The relative position of the view. " +
+				"Use zendesk_view_order instead to order several views",
 		},
 		"created_at": schema.StringAttribute{
 			Description: "The time the view was created.",
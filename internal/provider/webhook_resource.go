@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"github.com/devops-wiz/terraform-provider-zendesk/internal/models"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"strings"
 
 	"github.com/JacobPotter/go-zendesk/zendesk"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &WebhookResource{}
@@ -72,7 +74,7 @@ func (w *WebhookResource) Create(ctx context.Context, req resource.CreateRequest
 	webhookResp, err := w.client.CreateWebhook(ctx, newWebhook)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating webhook", err.Error())
+		resp.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error creating webhook", err, req.Plan.Schema)...)
 		return
 	}
 
@@ -108,6 +110,12 @@ func (w *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	webhookResp, err := w.client.GetWebhook(ctx, data.ID.ValueString())
 
+	if models.IsNotFound(err) {
+		tflog.Warn(ctx, "Webhook not found, removing from state", map[string]any{"id": data.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read Zendesk Webhook", err.Error())
 		return
@@ -160,7 +168,7 @@ func (w *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest
 	err := w.client.UpdateWebhook(ctx, data.ID.ValueString(), updatedWebhook)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating webhook", err.Error())
+		resp.Diagnostics.Append(models.APIErrorDiagnostics(ctx, "Error updating webhook", err, req.Plan.Schema)...)
 		return
 	}
 
@@ -200,7 +208,7 @@ func (w *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	err := w.client.DeleteWebhook(ctx, data.ID.ValueString())
 
-	if err != nil {
+	if err != nil && !models.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error deleting Webhook",
 			"Could not delete Webhook, unexpected error: "+err.Error(),
@@ -213,7 +221,21 @@ func (w *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 func (w *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data models.WebhookResourceModel
 
-	webhookResp, err := w.client.GetWebhook(ctx, req.ID)
+	webhookID := req.ID
+
+	// webhook IDs are not numeric, any ID with a prefix is resolved by name
+	if strings.Contains(webhookID, ":") {
+		var diags diag.Diagnostics
+		webhookID, diags = webhookImportLookup(w.client).Resolve(ctx, webhookID)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	webhookResp, err := w.client.GetWebhook(ctx, webhookID)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Error retrieving updated webhook", err.Error())
@@ -238,3 +260,15 @@ func (w *WebhookResource) ImportState(ctx context.Context, req resource.ImportSt
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func webhookImportLookup(zdClient *zendesk.Client) models.ImportLookup[zendesk.Webhook] {
+	return models.ImportLookup[zendesk.Webhook]{
+		List: func(ctx context.Context) ([]zendesk.Webhook, error) {
+			return listAll[zendesk.Webhook](ctx, zdClient, "/webhooks", "webhooks")
+		},
+		ID: func(webhook zendesk.Webhook) string { return webhook.ID },
+		Attributes: map[string]func(zendesk.Webhook) string{
+			"name": func(webhook zendesk.Webhook) string { return webhook.Name },
+		},
+	}
+}
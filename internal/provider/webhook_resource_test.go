@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/JacobPotter/go-zendesk/zendesk"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -94,3 +96,22 @@ func testAccCheckWebhookResourceExists(resourceName string, webhook *zendesk.Web
 
 	}
 }
+
+func TestWebhookResource_ReadNotFound(t *testing.T) {
+	w := &WebhookResource{client: testZendeskClient(t, testNotFoundHandler)}
+
+	state := testResourceState(t, WebhookSchema, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "01GB2A8DN0000000000000000"),
+	})
+	response := &fwresource.ReadResponse{State: state}
+
+	w.Read(t.Context(), fwresource.ReadRequest{State: state}, response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", response.Diagnostics)
+	}
+
+	if !response.State.Raw.IsNull() {
+		t.Fatalf("expected resource to be removed from state, got %s", response.State.Raw)
+	}
+}
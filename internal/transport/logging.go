@@ -0,0 +1,204 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/devops-wiz/terraform-provider-zendesk/internal/utils"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// LogSubsystem is the tflog subsystem used for Zendesk API wire logging.
+	LogSubsystem = "http"
+	// LogLevelEnv enables wire logging at the given level, ex: TF_LOG_PROVIDER_ZENDESK_HTTP=DEBUG.
+	LogLevelEnv = "TF_LOG_PROVIDER_ZENDESK_HTTP"
+
+	headerRateLimit = "X-Rate-Limit"
+
+	redactedValue   = "***"
+	maxLoggedBodyKB = 64
+)
+
+// sensitiveKeys are JSON keys whose values are redacted from logged bodies, at any depth.
+var sensitiveKeys = map[string]bool{
+	"access_token":   true,
+	"api_token":      true,
+	"authentication": true,
+	"bearer_token":   true,
+	"password":       true,
+	"secret":         true,
+	"signing_secret": true,
+	"token":          true,
+}
+
+var _ http.RoundTripper = &LoggingTransport{}
+
+// LoggingTransport is a http.RoundTripper logging every request and response through
+// the tflog LogSubsystem. Logging is off unless LogLevelEnv is set.
+//
+// Credentials, webhook authentication data and signing secrets are redacted from the
+// logged bodies, and the configured secrets are masked wherever they appear.
+type LoggingTransport struct {
+	// Base is the underlying transport, http.DefaultTransport when nil.
+	Base http.RoundTripper
+
+	secrets []string
+}
+
+// NewLoggingTransport returns a LoggingTransport wrapping base, masking the given secrets.
+func NewLoggingTransport(base http.RoundTripper, secrets ...string) *LoggingTransport {
+	return &LoggingTransport{
+		Base:    base,
+		secrets: utils.SliceFilter(secrets, func(secret string) bool { return secret != "" }),
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	// Bodies are only copied and redacted when they are going to be logged.
+	if !debugLogging() {
+		return base.RoundTrip(req)
+	}
+
+	ctx := t.newLogContext(req)
+
+	fields := map[string]any{
+		"method": req.Method,
+		"path":   req.URL.RequestURI(),
+	}
+
+	if body := requestBody(req); len(body) > 0 {
+		fields["request_body"] = redactBody(body)
+	}
+
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, LogSubsystem, "Zendesk API request failed", fields)
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+
+	for field, header := range map[string]string{
+		"rate_limit":           headerRateLimit,
+		"rate_limit_remaining": headerRateLimitRemaining,
+		"retry_after":          headerRetryAfter,
+	} {
+		if value := resp.Header.Get(header); value != "" {
+			fields[field] = value
+		}
+	}
+
+	if resp.Body != nil {
+		body, readErr := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		if readErr != nil {
+			return nil, readErr
+		}
+
+		if len(body) > 0 {
+			fields["response_body"] = redactBody(body)
+		}
+	}
+
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Zendesk API request", fields)
+
+	return resp, nil
+}
+
+// debugLogging reports whether LogLevelEnv enables the DEBUG level or a more verbose one.
+func debugLogging() bool {
+	level := hclog.LevelFromString(os.Getenv(LogLevelEnv))
+
+	return level != hclog.NoLevel && level <= hclog.Debug
+}
+
+func (t *LoggingTransport) newLogContext(req *http.Request) context.Context {
+	ctx := tflog.NewSubsystem(req.Context(), LogSubsystem, tflog.WithLevelFromEnv(LogLevelEnv))
+
+	if len(t.secrets) > 0 {
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, LogSubsystem, t.secrets...)
+		ctx = tflog.SubsystemMaskMessageStrings(ctx, LogSubsystem, t.secrets...)
+	}
+
+	return ctx
+}
+
+// requestBody returns a copy of the request body, leaving req untouched.
+func requestBody(req *http.Request) []byte {
+	if req.Body == nil || req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+
+	contents, err := io.ReadAll(body)
+	if err != nil {
+		return nil
+	}
+
+	return contents
+}
+
+// redactBody returns body with the values of sensitiveKeys redacted. Bodies which are not
+// JSON are not logged.
+func redactBody(body []byte) string {
+	var decoded any
+
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return "<non-JSON body omitted>"
+	}
+
+	redacted, err := json.Marshal(redactValue(decoded))
+	if err != nil {
+		return "<body omitted>"
+	}
+
+	if len(redacted) > maxLoggedBodyKB*1024 {
+		return string(redacted[:maxLoggedBodyKB*1024]) + "...<truncated>"
+	}
+
+	return string(redacted)
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, inner := range v {
+			if sensitiveKeys[strings.ToLower(key)] && inner != nil {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactValue(inner)
+		}
+	case []any:
+		for i, inner := range v {
+			v[i] = redactValue(inner)
+		}
+	}
+
+	return value
+}
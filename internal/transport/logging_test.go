@@ -0,0 +1,97 @@
+package transport
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLoggingTransport_RoundTrip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimitRemaining, "42")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"webhook":{"id":"1","name":"hook","signing_secret":{"algorithm":"sha256","secret":"shh"}}}`))
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		testName    string
+		level       string
+		expected    []string
+		notExpected []string
+	}{
+		{
+			testName: "should log redacted request and response",
+			level:    "DEBUG",
+			expected: []string{
+				`"method":"POST"`,
+				`"path":"/api/v2/webhooks"`,
+				`"status":200`,
+				`"rate_limit_remaining":"42"`,
+				`\"authentication\":\"***\"`,
+				`\"signing_secret\":\"***\"`,
+				`\"name\":\"hook\"`,
+			},
+			notExpected: []string{"hunter2", "shh", "my-api-token"},
+		},
+		{
+			testName:    "should not log when disabled",
+			level:       "",
+			notExpected: []string{"Zendesk API request"},
+		},
+		{
+			testName:    "should not log above DEBUG",
+			level:       "INFO",
+			notExpected: []string{"Zendesk API request"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			t.Setenv(LogLevelEnv, c.level)
+
+			var output bytes.Buffer
+			ctx := tflogtest.RootLogger(t.Context(), &output)
+
+			body := `{"webhook":{"name":"hook","authentication":{"type":"basic_auth","data":{"username":"u","password":"hunter2"}},"note":"my-api-token"}}`
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/api/v2/webhooks", strings.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := NewLoggingTransport(nil, "my-api-token", "").RoundTrip(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			defer resp.Body.Close()
+
+			var respBody bytes.Buffer
+			if _, err := respBody.ReadFrom(resp.Body); err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(respBody.String(), `"secret":"shh"`) {
+				t.Fatalf("expected response body to be left intact, got %s", respBody.String())
+			}
+
+			logs := output.String()
+
+			for _, expected := range c.expected {
+				if !strings.Contains(logs, expected) {
+					t.Errorf("expected logs to contain %s, got %s", expected, logs)
+				}
+			}
+
+			for _, notExpected := range c.notExpected {
+				if strings.Contains(logs, notExpected) {
+					t.Errorf("expected logs not to contain %s, got %s", notExpected, logs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	headerRetryAfter         = "Retry-After"
+	headerRateLimitRemaining = "X-Rate-Limit-Remaining"
+	headerRateLimitReset     = "Ratelimit-Reset"
+
+	defaultMinWait = time.Second
+)
+
+var _ http.RoundTripper = &RetryTransport{}
+
+// RetryTransport is a http.RoundTripper that retries rate limited (429) responses and
+// idempotent requests failing with a 5xx status, using Retry-After when provided and
+// jittered exponential backoff otherwise.
+//
+// When Zendesk reports the rate limit as exhausted, every request sharing the transport
+// waits until the limit resets instead of triggering further 429 responses.
+type RetryTransport struct {
+	// Base is the underlying transport, http.DefaultTransport when nil.
+	Base http.RoundTripper
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+	// MaxWait caps the wait between two attempts.
+	MaxWait time.Duration
+	// MinWait is the initial backoff, doubled on every retry.
+	MinWait time.Duration
+
+	mu       sync.Mutex
+	resumeAt time.Time
+}
+
+// NewRetryTransport returns a RetryTransport wrapping base.
+func NewRetryTransport(base http.RoundTripper, maxRetries int, maxWait time.Duration) *RetryTransport {
+	return &RetryTransport{
+		Base:       base,
+		MaxRetries: maxRetries,
+		MaxWait:    maxWait,
+		MinWait:    defaultMinWait,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		if err := sleep(ctx, t.waitForRateLimit()); err != nil {
+			return nil, err
+		}
+
+		attemptReq, err := rewindRequest(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.base().RoundTrip(attemptReq)
+		if err != nil {
+			return nil, err
+		}
+
+		if pause, ok := rateLimitPause(resp); ok {
+			t.pauseUntil(time.Now().Add(t.capWait(pause)))
+		}
+
+		if attempt >= t.MaxRetries || !t.shouldRetry(req, resp) {
+			return resp, nil
+		}
+
+		if req.Body != nil && req.GetBody == nil {
+			return resp, nil
+		}
+
+		wait := t.backoff(attempt)
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get(headerRetryAfter)); ok {
+			wait = t.capWait(retryAfter)
+		}
+
+		tflog.Debug(ctx, "Retrying Zendesk API request", map[string]any{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"status":  resp.StatusCode,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		})
+
+		drainBody(resp)
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (t *RetryTransport) base() http.RoundTripper {
+	if t.Base == nil {
+		return http.DefaultTransport
+	}
+	return t.Base
+}
+
+// shouldRetry reports whether resp may be retried. Rate limited requests were not
+// processed and are always safe to retry, server errors only for idempotent methods.
+func (t *RetryTransport) shouldRetry(req *http.Request, resp *http.Response) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	}
+
+	return false
+}
+
+// backoff returns the jittered exponential backoff for the given attempt, between half
+// and all of MinWait * 2^attempt, capped at MaxWait.
+func (t *RetryTransport) backoff(attempt int) time.Duration {
+	minWait := t.MinWait
+	if minWait <= 0 {
+		minWait = defaultMinWait
+	}
+
+	wait := t.capWait(minWait << min(attempt, 30))
+
+	if half := int64(wait / 2); half > 0 {
+		wait = time.Duration(half + rand.Int64N(half+1))
+	}
+
+	return wait
+}
+
+func (t *RetryTransport) capWait(wait time.Duration) time.Duration {
+	if t.MaxWait > 0 && (wait > t.MaxWait || wait < 0) {
+		return t.MaxWait
+	}
+	return wait
+}
+
+func (t *RetryTransport) pauseUntil(resumeAt time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if resumeAt.After(t.resumeAt) {
+		t.resumeAt = resumeAt
+	}
+}
+
+func (t *RetryTransport) waitForRateLimit() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return time.Until(t.resumeAt)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// rateLimitPause returns how long requests should be held back when resp reports the
+// account rate limit as exhausted, using Retry-After or else the rate limit reset.
+func rateLimitPause(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.Header.Get(headerRateLimitRemaining) != "0" {
+		return 0, false
+	}
+
+	if pause, ok := parseRetryAfter(resp.Header.Get(headerRetryAfter)); ok {
+		return pause, true
+	}
+
+	return parseRetryAfter(resp.Header.Get(headerRateLimitReset))
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as a HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+// rewindRequest returns the request to send for the given attempt, with a fresh body
+// for every retry.
+func rewindRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil || req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	rewound := req.Clone(req.Context())
+	rewound.Body = body
+
+	return rewound, nil
+}
+
+func drainBody(resp *http.Response) {
+	if resp.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+	_ = resp.Body.Close()
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
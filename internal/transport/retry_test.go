@@ -0,0 +1,195 @@
+package transport
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestRetryTransport(maxRetries int) *RetryTransport {
+	t := NewRetryTransport(nil, maxRetries, 50*time.Millisecond)
+	t.MinWait = time.Millisecond
+	return t
+}
+
+func TestRetryTransport_RoundTrip(t *testing.T) {
+	cases := []struct {
+		testName         string
+		method           string
+		statuses         []int
+		headers          http.Header
+		maxRetries       int
+		expectedStatus   int
+		expectedAttempts int32
+	}{
+		{
+			testName:         "should not retry successful request",
+			method:           http.MethodGet,
+			statuses:         []int{http.StatusOK},
+			maxRetries:       3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 1,
+		},
+		{
+			testName:         "should retry rate limited request",
+			method:           http.MethodPost,
+			statuses:         []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusCreated},
+			headers:          http.Header{headerRetryAfter: []string{"0"}},
+			maxRetries:       3,
+			expectedStatus:   http.StatusCreated,
+			expectedAttempts: 3,
+		},
+		{
+			testName:         "should retry idempotent request on server error",
+			method:           http.MethodPut,
+			statuses:         []int{http.StatusBadGateway, http.StatusOK},
+			maxRetries:       3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 2,
+		},
+		{
+			testName:         "should not retry non idempotent request on server error",
+			method:           http.MethodPost,
+			statuses:         []int{http.StatusInternalServerError, http.StatusCreated},
+			maxRetries:       3,
+			expectedStatus:   http.StatusInternalServerError,
+			expectedAttempts: 1,
+		},
+		{
+			testName:         "should not retry client error",
+			method:           http.MethodGet,
+			statuses:         []int{http.StatusNotFound, http.StatusOK},
+			maxRetries:       3,
+			expectedStatus:   http.StatusNotFound,
+			expectedAttempts: 1,
+		},
+		{
+			testName:         "should stop after max retries",
+			method:           http.MethodGet,
+			statuses:         []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			maxRetries:       1,
+			expectedStatus:   http.StatusServiceUnavailable,
+			expectedAttempts: 2,
+		},
+		{
+			testName:         "should cap retry after at max wait",
+			method:           http.MethodGet,
+			statuses:         []int{http.StatusTooManyRequests, http.StatusOK},
+			headers:          http.Header{headerRetryAfter: []string{"3600"}},
+			maxRetries:       1,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 2,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			var attempts atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := attempts.Add(1)
+
+				if body, _ := io.ReadAll(r.Body); r.Method != http.MethodGet && string(body) != "payload" {
+					t.Errorf("attempt %d: expected body %q, got %q", attempt, "payload", body)
+				}
+
+				for key, values := range c.headers {
+					w.Header()[key] = values
+				}
+				w.WriteHeader(c.statuses[min(int(attempt), len(c.statuses))-1])
+			}))
+			defer server.Close()
+
+			var body io.Reader
+			if c.method != http.MethodGet {
+				body = strings.NewReader("payload")
+			}
+
+			req, err := http.NewRequestWithContext(t.Context(), c.method, server.URL, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			start := time.Now()
+			resp, err := newTestRetryTransport(c.maxRetries).RoundTrip(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			_ = resp.Body.Close()
+
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Fatalf("expected waits to be capped, took %s", elapsed)
+			}
+
+			if resp.StatusCode != c.expectedStatus {
+				t.Fatalf("expected status %d, got %d", c.expectedStatus, resp.StatusCode)
+			}
+
+			if got := attempts.Load(); got != c.expectedAttempts {
+				t.Fatalf("expected %d attempts, got %d", c.expectedAttempts, got)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_RateLimitExhausted(t *testing.T) {
+	var attempts atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set(headerRateLimitRemaining, "0")
+			w.Header().Set(headerRetryAfter, "1")
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	rt := newTestRetryTransport(0)
+	rt.MaxWait = 100 * time.Millisecond
+
+	for range 2 {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		start := time.Now()
+		resp, err := rt.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		_ = resp.Body.Close()
+
+		if attempts.Load() == 2 && time.Since(start) < 50*time.Millisecond {
+			t.Fatalf("expected request to wait for rate limit reset, took %s", time.Since(start))
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	cases := []struct {
+		testName string
+		input    string
+		expected time.Duration
+		ok       bool
+	}{
+		{testName: "should parse seconds", input: "30", expected: 30 * time.Second, ok: true},
+		{testName: "should ignore empty value", input: "", ok: false},
+		{testName: "should ignore negative seconds", input: "-1", ok: false},
+		{testName: "should ignore invalid value", input: "soon", ok: false},
+		{testName: "should clamp past date", input: "Mon, 01 Jan 2001 00:00:00 GMT", expected: 0, ok: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			out, ok := parseRetryAfter(c.input)
+			if out != c.expected || ok != c.ok {
+				t.Fatalf("expected (%s, %t), got (%s, %t)", c.expected, c.ok, out, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+var _ http.RoundTripper = &ThrottleTransport{}
+
+// ThrottleTransport is a http.RoundTripper limiting the rate and the concurrency of the
+// requests sent through it. A single ThrottleTransport is shared by every resource, so the
+// limits apply to the provider as a whole.
+type ThrottleTransport struct {
+	// Base is the underlying transport, http.DefaultTransport when nil.
+	Base http.RoundTripper
+
+	limiter *rateLimiter
+	slots   chan struct{}
+}
+
+// NewThrottleTransport returns a ThrottleTransport wrapping base, allowing requestsPerMinute
+// requests per minute with at most maxConcurrent requests in flight. A limit of zero or
+// less disables it.
+func NewThrottleTransport(base http.RoundTripper, requestsPerMinute int, maxConcurrent int) *ThrottleTransport {
+	t := &ThrottleTransport{Base: base}
+
+	if requestsPerMinute > 0 {
+		t.limiter = &rateLimiter{interval: time.Minute / time.Duration(requestsPerMinute)}
+	}
+
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *ThrottleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	release := func() {}
+
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+			var once sync.Once
+			release = func() { once.Do(func() { <-t.slots }) }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if t.limiter != nil {
+		if err := t.limiter.wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	if resp.Body == nil {
+		release()
+		return resp, nil
+	}
+
+	// The slot is held until the caller is done reading the response.
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+
+	return resp, nil
+}
+
+// releaseOnClose is a response body releasing its concurrency slot once closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
+
+// rateLimiter is a token bucket holding a single token, refilled every interval, which
+// spreads requests evenly over the minute.
+type rateLimiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// wait blocks until the caller may send its request. The reserved slot is kept even when
+// ctx is cancelled.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	return sleep(ctx, delay)
+}
@@ -0,0 +1,134 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestThrottleTransport_RequestsPerMinute(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// 1200 requests per minute is one request every 50ms
+	rt := NewThrottleTransport(nil, 1200, 0)
+
+	start := time.Now()
+
+	for range 3 {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resp, err := rt.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Fatalf("expected 3 requests to take at least 100ms, took %s", elapsed)
+	}
+}
+
+func TestThrottleTransport_MaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	rt := NewThrottleTransport(nil, 0, 2)
+
+	var wg sync.WaitGroup
+
+	for range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			_ = resp.Body.Close()
+		}()
+	}
+
+	wg.Wait()
+
+	if got := maxInFlight.Load(); got > 2 {
+		t.Fatalf("expected at most 2 concurrent requests, got %d", got)
+	}
+}
+
+func TestThrottleTransport_MaxConcurrentRequestsUntilBodyClosed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	rt := NewThrottleTransport(nil, 0, 1)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := rt.RoundTrip(req); err == nil {
+		t.Fatal("expected the second request to wait for the first body to be closed")
+	}
+
+	_ = resp.Body.Close()
+
+	req, err = http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err = rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error after closing the body: %s", err)
+	}
+	_ = resp.Body.Close()
+}
@@ -0,0 +1,52 @@
+// Package zendeskapi covers the Zendesk API endpoints missing from go-zendesk, following the
+// go-zendesk client conventions.
+package zendeskapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/JacobPotter/go-zendesk/client"
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+// Client extends the go-zendesk client with the endpoints of this package, every go-zendesk
+// method stays available.
+type Client struct {
+	*zendesk.Client
+}
+
+// NewClient wraps a configured go-zendesk client.
+func NewClient(zdClient *zendesk.Client) *Client {
+	return &Client{Client: zdClient}
+}
+
+// deleteWithBody sends a DELETE request to endpoints answering with the deleted object, which
+// the go-zendesk Delete method rejects as it only accepts 204 No Content.
+func (z *Client) deleteWithBody(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodDelete, z.BaseURL.String()+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = z.PrepareRequest(ctx, req)
+
+	resp, err := z.HttpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return nil, client.NewError(body, resp)
+	}
+
+	return body, nil
+}
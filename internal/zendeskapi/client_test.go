@@ -0,0 +1,46 @@
+package zendeskapi
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+// testRequest records the last request received by a test client.
+type testRequest struct {
+	method string
+	path   string
+	body   string
+}
+
+// newTestClient returns a client sending every request to a server answering with status
+// and body.
+func newTestClient(t *testing.T, status int, body string) (*Client, *testRequest) {
+	t.Helper()
+
+	received := &testRequest{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqBody, _ := io.ReadAll(r.Body)
+		*received = testRequest{method: r.Method, path: r.URL.RequestURI(), body: string(reqBody)}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	zdClient, err := zendesk.NewClient(server.Client())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := zdClient.SetEndpointURL(server.URL + "/api/v2"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return NewClient(zdClient), received
+}
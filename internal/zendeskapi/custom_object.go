@@ -0,0 +1,231 @@
+package zendeskapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+// CustomObject is a custom object definition, its key identifies it and can't be changed.
+// The raw fields hold titles and description before dynamic content is rendered.
+// https://developer.zendesk.com/api-reference/custom-data/custom-objects/custom_objects/
+type CustomObject struct {
+	Key                string     `json:"key,omitempty"`
+	URL                string     `json:"url,omitempty"`
+	Title              string     `json:"title"`
+	TitlePluralized    string     `json:"title_pluralized"`
+	Description        string     `json:"description"`
+	RawTitle           string     `json:"raw_title,omitempty"`
+	RawTitlePluralized string     `json:"raw_title_pluralized,omitempty"`
+	RawDescription     string     `json:"raw_description,omitempty"`
+	CreatedAt          *time.Time `json:"created_at,omitempty"`
+	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
+}
+
+// CustomObjectField is a field of a custom object, it shares the options and relationship
+// filter of user and organization fields.
+// https://developer.zendesk.com/api-reference/custom-data/custom-objects/custom_object_fields/
+type CustomObjectField struct {
+	ID                     int64                       `json:"id,omitempty"`
+	URL                    string                      `json:"url,omitempty"`
+	Key                    string                      `json:"key,omitempty"`
+	Type                   string                      `json:"type,omitempty"`
+	Title                  string                      `json:"title"`
+	Description            string                      `json:"description"`
+	Position               int64                       `json:"position,omitempty"`
+	Active                 bool                        `json:"active"`
+	System                 bool                        `json:"system,omitempty"`
+	RegexpForValidation    string                      `json:"regexp_for_validation,omitempty"`
+	Tag                    string                      `json:"tag,omitempty"`
+	CustomFieldOptions     []zendesk.CustomFieldOption `json:"custom_field_options,omitempty"`
+	RelationshipTargetType string                      `json:"relationship_target_type,omitempty"`
+	RelationshipFilter     *zendesk.RelationshipFilter `json:"relationship_filter,omitempty"`
+	CreatedAt              *time.Time                  `json:"created_at,omitempty"`
+	UpdatedAt              *time.Time                  `json:"updated_at,omitempty"`
+}
+
+type CustomObjectAPI interface {
+	GetCustomObjects(ctx context.Context) ([]CustomObject, error)
+	GetCustomObject(ctx context.Context, key string) (CustomObject, error)
+	CreateCustomObject(ctx context.Context, object CustomObject) (CustomObject, error)
+	UpdateCustomObject(ctx context.Context, key string, object CustomObject) (CustomObject, error)
+	DeleteCustomObject(ctx context.Context, key string) error
+	GetCustomObjectFields(ctx context.Context, objectKey string) ([]CustomObjectField, error)
+	GetCustomObjectField(ctx context.Context, objectKey string, fieldID int64) (CustomObjectField, error)
+	CreateCustomObjectField(ctx context.Context, objectKey string, field CustomObjectField) (CustomObjectField, error)
+	UpdateCustomObjectField(ctx context.Context, objectKey string, fieldID int64, field CustomObjectField) (CustomObjectField, error)
+	DeleteCustomObjectField(ctx context.Context, objectKey string, fieldID int64) error
+}
+
+var _ CustomObjectAPI = &Client{}
+
+func (z *Client) GetCustomObjects(ctx context.Context) ([]CustomObject, error) {
+	var result struct {
+		CustomObjects []CustomObject `json:"custom_objects"`
+	}
+
+	body, err := z.Get(ctx, "/custom_objects.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.CustomObjects, nil
+}
+
+func (z *Client) GetCustomObject(ctx context.Context, key string) (CustomObject, error) {
+	var result struct {
+		CustomObject CustomObject `json:"custom_object"`
+	}
+
+	body, err := z.Get(ctx, fmt.Sprintf("/custom_objects/%s.json", key))
+	if err != nil {
+		return CustomObject{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObject{}, err
+	}
+
+	return result.CustomObject, nil
+}
+
+func (z *Client) CreateCustomObject(ctx context.Context, object CustomObject) (CustomObject, error) {
+	var data, result struct {
+		CustomObject CustomObject `json:"custom_object"`
+	}
+
+	data.CustomObject = object
+
+	body, err := z.Post(ctx, "/custom_objects.json", data)
+	if err != nil {
+		return CustomObject{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObject{}, err
+	}
+
+	return result.CustomObject, nil
+}
+
+// UpdateCustomObject updates a custom object, the key can't be changed once the object is
+// created.
+func (z *Client) UpdateCustomObject(ctx context.Context, key string, object CustomObject) (CustomObject, error) {
+	var data, result struct {
+		CustomObject CustomObject `json:"custom_object"`
+	}
+
+	data.CustomObject = object
+	data.CustomObject.Key = ""
+
+	body, err := z.Patch(ctx, fmt.Sprintf("/custom_objects/%s.json", key), data)
+	if err != nil {
+		return CustomObject{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObject{}, err
+	}
+
+	return result.CustomObject, nil
+}
+
+// DeleteCustomObject deletes a custom object, Zendesk rejects it while the object has records.
+func (z *Client) DeleteCustomObject(ctx context.Context, key string) error {
+	return z.Delete(ctx, fmt.Sprintf("/custom_objects/%s.json", key))
+}
+
+func (z *Client) GetCustomObjectFields(ctx context.Context, objectKey string) ([]CustomObjectField, error) {
+	var result struct {
+		CustomObjectFields []CustomObjectField `json:"custom_object_fields"`
+	}
+
+	body, err := z.Get(ctx, fmt.Sprintf("/custom_objects/%s/fields.json", objectKey))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.CustomObjectFields, nil
+}
+
+func (z *Client) GetCustomObjectField(ctx context.Context, objectKey string, fieldID int64) (CustomObjectField, error) {
+	var result struct {
+		CustomObjectField CustomObjectField `json:"custom_object_field"`
+	}
+
+	body, err := z.Get(ctx, fmt.Sprintf("/custom_objects/%s/fields/%d.json", objectKey, fieldID))
+	if err != nil {
+		return CustomObjectField{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObjectField{}, err
+	}
+
+	return result.CustomObjectField, nil
+}
+
+func (z *Client) CreateCustomObjectField(ctx context.Context, objectKey string, field CustomObjectField) (CustomObjectField, error) {
+	var data, result struct {
+		CustomObjectField CustomObjectField `json:"custom_object_field"`
+	}
+
+	data.CustomObjectField = field
+
+	body, err := z.Post(ctx, fmt.Sprintf("/custom_objects/%s/fields.json", objectKey), data)
+	if err != nil {
+		return CustomObjectField{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObjectField{}, err
+	}
+
+	return result.CustomObjectField, nil
+}
+
+// UpdateCustomObjectField updates a custom object field, the key and type can't be changed
+// once the field is created.
+func (z *Client) UpdateCustomObjectField(ctx context.Context, objectKey string, fieldID int64, field CustomObjectField) (CustomObjectField, error) {
+	var data, result struct {
+		CustomObjectField CustomObjectField `json:"custom_object_field"`
+	}
+
+	data.CustomObjectField = field
+	data.CustomObjectField.Key = ""
+	data.CustomObjectField.Type = ""
+
+	body, err := z.Patch(ctx, fmt.Sprintf("/custom_objects/%s/fields/%d.json", objectKey, fieldID), data)
+	if err != nil {
+		return CustomObjectField{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObjectField{}, err
+	}
+
+	return result.CustomObjectField, nil
+}
+
+func (z *Client) DeleteCustomObjectField(ctx context.Context, objectKey string, fieldID int64) error {
+	return z.Delete(ctx, fmt.Sprintf("/custom_objects/%s/fields/%d.json", objectKey, fieldID))
+}
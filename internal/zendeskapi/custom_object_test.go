@@ -0,0 +1,110 @@
+package zendeskapi
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+func TestCreateCustomObject(t *testing.T) {
+	client, received := newTestClient(t, http.StatusCreated, `{"custom_object":{"key":"asset","title":"Asset","title_pluralized":"Assets","raw_title":"Asset"}}`)
+
+	object, err := client.CreateCustomObject(t.Context(), CustomObject{
+		Key:             "asset",
+		Title:           "Asset",
+		TitlePluralized: "Assets",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPost,
+		path:   "/api/v2/custom_objects.json",
+		body:   `{"custom_object":{"key":"asset","title":"Asset","title_pluralized":"Assets","description":""}}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+
+	if object.Key != "asset" || object.RawTitle != "Asset" {
+		t.Fatalf("unexpected custom object %+v", object)
+	}
+}
+
+func TestUpdateCustomObject(t *testing.T) {
+	client, received := newTestClient(t, http.StatusOK, `{"custom_object":{"key":"asset","title":"Hardware asset"}}`)
+
+	_, err := client.UpdateCustomObject(t.Context(), "asset", CustomObject{
+		Key:             "asset",
+		Title:           "Hardware asset",
+		TitlePluralized: "Hardware assets",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPatch,
+		path:   "/api/v2/custom_objects/asset.json",
+		body:   `{"custom_object":{"title":"Hardware asset","title_pluralized":"Hardware assets","description":""}}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+}
+
+func TestCreateCustomObjectField(t *testing.T) {
+	client, received := newTestClient(t, http.StatusCreated, `{"custom_object_field":{"id":1,"key":"contract","type":"lookup","title":"Contract","active":true,"relationship_target_type":"zen:custom_object:contract"}}`)
+
+	field, err := client.CreateCustomObjectField(t.Context(), "asset", CustomObjectField{
+		Key:                    "contract",
+		Type:                   "lookup",
+		Title:                  "Contract",
+		Active:                 true,
+		RelationshipTargetType: "zen:custom_object:contract",
+		RelationshipFilter: &zendesk.RelationshipFilter{
+			All: []zendesk.RelationshipFilterObject{{Field: "status", Operator: "is", Value: "active"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPost,
+		path:   "/api/v2/custom_objects/asset/fields.json",
+		body:   `{"custom_object_field":{"key":"contract","type":"lookup","title":"Contract","description":"","active":true,"relationship_target_type":"zen:custom_object:contract","relationship_filter":{"all":[{"field":"status","operator":"is","value":"active"}],"any":null}}}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+
+	if field.ID != 1 {
+		t.Fatalf("unexpected custom object field %+v", field)
+	}
+}
+
+func TestUpdateCustomObjectField(t *testing.T) {
+	client, received := newTestClient(t, http.StatusOK, `{"custom_object_field":{"id":1,"key":"serial","type":"text","title":"Serial number"}}`)
+
+	_, err := client.UpdateCustomObjectField(t.Context(), "asset", 1, CustomObjectField{
+		Key:    "serial",
+		Type:   "text",
+		Title:  "Serial number",
+		Active: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPatch,
+		path:   "/api/v2/custom_objects/asset/fields/1.json",
+		body:   `{"custom_object_field":{"title":"Serial number","description":"","active":true}}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+}
@@ -0,0 +1,116 @@
+package zendeskapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// CustomStatus is a custom ticket status, it refines one of the ticket status categories.
+// The raw fields hold labels and descriptions before dynamic content is rendered.
+// https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/
+type CustomStatus struct {
+	ID                    int64      `json:"id,omitempty"`
+	StatusCategory        string     `json:"status_category,omitempty"`
+	AgentLabel            string     `json:"agent_label"`
+	EndUserLabel          string     `json:"end_user_label,omitempty"`
+	Description           string     `json:"description"`
+	EndUserDescription    string     `json:"end_user_description"`
+	Active                bool       `json:"active"`
+	Default               bool       `json:"default,omitempty"`
+	RawAgentLabel         string     `json:"raw_agent_label,omitempty"`
+	RawEndUserLabel       string     `json:"raw_end_user_label,omitempty"`
+	RawDescription        string     `json:"raw_description,omitempty"`
+	RawEndUserDescription string     `json:"raw_end_user_description,omitempty"`
+	CreatedAt             *time.Time `json:"created_at,omitempty"`
+	UpdatedAt             *time.Time `json:"updated_at,omitempty"`
+}
+
+type CustomStatusAPI interface {
+	GetCustomStatuses(ctx context.Context) ([]CustomStatus, error)
+	GetCustomStatus(ctx context.Context, statusID int64) (CustomStatus, error)
+	CreateCustomStatus(ctx context.Context, status CustomStatus) (CustomStatus, error)
+	UpdateCustomStatus(ctx context.Context, statusID int64, status CustomStatus) (CustomStatus, error)
+}
+
+var _ CustomStatusAPI = &Client{}
+
+func (z *Client) GetCustomStatuses(ctx context.Context) ([]CustomStatus, error) {
+	var result struct {
+		CustomStatuses []CustomStatus `json:"custom_statuses"`
+	}
+
+	body, err := z.Get(ctx, "/custom_statuses.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.CustomStatuses, nil
+}
+
+func (z *Client) GetCustomStatus(ctx context.Context, statusID int64) (CustomStatus, error) {
+	var result struct {
+		CustomStatus CustomStatus `json:"custom_status"`
+	}
+
+	body, err := z.Get(ctx, fmt.Sprintf("/custom_statuses/%d.json", statusID))
+	if err != nil {
+		return CustomStatus{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomStatus{}, err
+	}
+
+	return result.CustomStatus, nil
+}
+
+func (z *Client) CreateCustomStatus(ctx context.Context, status CustomStatus) (CustomStatus, error) {
+	var data, result struct {
+		CustomStatus CustomStatus `json:"custom_status"`
+	}
+
+	data.CustomStatus = status
+
+	body, err := z.Post(ctx, "/custom_statuses.json", data)
+	if err != nil {
+		return CustomStatus{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomStatus{}, err
+	}
+
+	return result.CustomStatus, nil
+}
+
+// UpdateCustomStatus updates a custom status, the status category can't be changed once the
+// status is created.
+func (z *Client) UpdateCustomStatus(ctx context.Context, statusID int64, status CustomStatus) (CustomStatus, error) {
+	var data, result struct {
+		CustomStatus CustomStatus `json:"custom_status"`
+	}
+
+	data.CustomStatus = status
+	data.CustomStatus.StatusCategory = ""
+
+	body, err := z.Put(ctx, fmt.Sprintf("/custom_statuses/%d.json", statusID), data)
+	if err != nil {
+		return CustomStatus{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomStatus{}, err
+	}
+
+	return result.CustomStatus, nil
+}
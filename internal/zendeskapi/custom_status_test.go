@@ -0,0 +1,53 @@
+package zendeskapi
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestCreateCustomStatus(t *testing.T) {
+	client, received := newTestClient(t, http.StatusCreated, `{"custom_status":{"id":1,"status_category":"pending","agent_label":"Waiting on vendor","raw_agent_label":"Waiting on vendor","active":true}}`)
+
+	status, err := client.CreateCustomStatus(t.Context(), CustomStatus{
+		StatusCategory: "pending",
+		AgentLabel:     "Waiting on vendor",
+		Active:         true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPost,
+		path:   "/api/v2/custom_statuses.json",
+		body:   `{"custom_status":{"status_category":"pending","agent_label":"Waiting on vendor","description":"","end_user_description":"","active":true}}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+
+	if status.ID != 1 || status.RawAgentLabel != "Waiting on vendor" {
+		t.Fatalf("unexpected status %+v", status)
+	}
+}
+
+func TestUpdateCustomStatus(t *testing.T) {
+	client, received := newTestClient(t, http.StatusOK, `{"custom_status":{"id":1,"status_category":"pending","active":false}}`)
+
+	_, err := client.UpdateCustomStatus(t.Context(), 1, CustomStatus{
+		StatusCategory: "pending",
+		AgentLabel:     "Waiting on vendor",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPut,
+		path:   "/api/v2/custom_statuses/1.json",
+		body:   `{"custom_status":{"agent_label":"Waiting on vendor","description":"","end_user_description":"","active":false}}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+}
@@ -0,0 +1,112 @@
+package zendeskapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+// GroupMembershipAPI covers the group membership endpoints missing from go-zendesk, which
+// only lists memberships.
+// https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/
+type GroupMembershipAPI interface {
+	GetGroupMembership(ctx context.Context, membershipID int64) (zendesk.GroupMembership, error)
+	GetGroupMembershipsByGroup(ctx context.Context, groupID int64) ([]zendesk.GroupMembership, error)
+	CreateGroupMembership(ctx context.Context, membership zendesk.GroupMembership) (zendesk.GroupMembership, error)
+	MakeGroupMembershipDefault(ctx context.Context, userID, membershipID int64) error
+	DeleteGroupMembership(ctx context.Context, membershipID int64) error
+}
+
+var _ GroupMembershipAPI = &Client{}
+
+func (z *Client) GetGroupMembership(ctx context.Context, membershipID int64) (zendesk.GroupMembership, error) {
+	var result struct {
+		GroupMembership zendesk.GroupMembership `json:"group_membership"`
+	}
+
+	body, err := z.Get(ctx, fmt.Sprintf("/group_memberships/%d.json", membershipID))
+	if err != nil {
+		return zendesk.GroupMembership{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return zendesk.GroupMembership{}, err
+	}
+
+	return result.GroupMembership, nil
+}
+
+// GetGroupMembershipsByGroup returns every membership of a group, following pagination.
+func (z *Client) GetGroupMembershipsByGroup(ctx context.Context, groupID int64) ([]zendesk.GroupMembership, error) {
+	var memberships []zendesk.GroupMembership
+
+	for page := 1; ; page++ {
+		var result struct {
+			GroupMemberships []zendesk.GroupMembership `json:"group_memberships"`
+			zendesk.Page
+		}
+
+		body, err := z.Get(ctx, fmt.Sprintf("/groups/%d/memberships.json?page=%d&per_page=100", groupID, page))
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		memberships = append(memberships, result.GroupMemberships...)
+
+		if !result.HasNext() {
+			return memberships, nil
+		}
+	}
+}
+
+// CreateGroupMembership adds a user to a group, only the user and group IDs and the default
+// flag are sent.
+func (z *Client) CreateGroupMembership(ctx context.Context, membership zendesk.GroupMembership) (zendesk.GroupMembership, error) {
+	var data struct {
+		GroupMembership struct {
+			UserID  int64 `json:"user_id"`
+			GroupID int64 `json:"group_id"`
+			Default bool  `json:"default,omitempty"`
+		} `json:"group_membership"`
+	}
+
+	var result struct {
+		GroupMembership zendesk.GroupMembership `json:"group_membership"`
+	}
+
+	data.GroupMembership.UserID = membership.UserID
+	data.GroupMembership.GroupID = membership.GroupID
+	data.GroupMembership.Default = membership.Default
+
+	body, err := z.Post(ctx, "/group_memberships.json", data)
+	if err != nil {
+		return zendesk.GroupMembership{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return zendesk.GroupMembership{}, err
+	}
+
+	return result.GroupMembership, nil
+}
+
+// MakeGroupMembershipDefault makes a membership the default group of its user, the previous
+// default membership of the user loses its flag.
+func (z *Client) MakeGroupMembershipDefault(ctx context.Context, userID, membershipID int64) error {
+	_, err := z.Put(ctx, fmt.Sprintf("/users/%d/group_memberships/%d/make_default.json", userID, membershipID), struct{}{})
+
+	return err
+}
+
+func (z *Client) DeleteGroupMembership(ctx context.Context, membershipID int64) error {
+	return z.Delete(ctx, fmt.Sprintf("/group_memberships/%d.json", membershipID))
+}
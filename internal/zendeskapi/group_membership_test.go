@@ -0,0 +1,81 @@
+package zendeskapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+func TestCreateGroupMembership(t *testing.T) {
+	client, received := newTestClient(t, http.StatusCreated, `{"group_membership":{"id":3,"user_id":1,"group_id":2,"default":false}}`)
+
+	membership, err := client.CreateGroupMembership(t.Context(), zendesk.GroupMembership{UserID: 1, GroupID: 2, Name: "ignored"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPost,
+		path:   "/api/v2/group_memberships.json",
+		body:   `{"group_membership":{"user_id":1,"group_id":2}}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+
+	if membership.ID != 3 {
+		t.Fatalf("expected membership 3, got %d", membership.ID)
+	}
+}
+
+func TestMakeGroupMembershipDefault(t *testing.T) {
+	client, received := newTestClient(t, http.StatusOK, `{"group_memberships":[]}`)
+
+	if err := client.MakeGroupMembershipDefault(t.Context(), 1, 3); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if received.method != http.MethodPut || received.path != "/api/v2/users/1/group_memberships/3/make_default.json" {
+		t.Fatalf("unexpected request %+v", *received)
+	}
+}
+
+func TestGetGroupMembershipsByGroup(t *testing.T) {
+	var paths []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.RequestURI())
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "1" {
+			_, _ = w.Write([]byte(`{"group_memberships":[{"id":1,"user_id":10}],"next_page":"next"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"group_memberships":[{"id":2,"user_id":20}],"next_page":null}`))
+	}))
+	t.Cleanup(server.Close)
+
+	zdClient, err := zendesk.NewClient(server.Client())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := zdClient.SetEndpointURL(server.URL + "/api/v2"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	memberships, err := NewClient(zdClient).GetGroupMembershipsByGroup(t.Context(), 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(memberships) != 2 || memberships[0].UserID != 10 || memberships[1].UserID != 20 {
+		t.Fatalf("unexpected memberships %+v", memberships)
+	}
+
+	if len(paths) != 2 || paths[1] != "/api/v2/groups/5/memberships.json?page=2&per_page=100" {
+		t.Fatalf("unexpected requests %v", paths)
+	}
+}
@@ -0,0 +1,33 @@
+package zendeskapi
+
+import (
+	"context"
+)
+
+// MacroPosition is the position of a macro in a bulk update.
+type MacroPosition struct {
+	ID       int64 `json:"id"`
+	Position int64 `json:"position"`
+}
+
+type MacroAPI interface {
+	UpdateMacroPositions(ctx context.Context, ids []int64) error
+}
+
+var _ MacroAPI = &Client{}
+
+// UpdateMacroPositions sets the position of every macro from its index in ids, in one bulk update.
+func (z *Client) UpdateMacroPositions(ctx context.Context, ids []int64) error {
+	var data struct {
+		Macros []MacroPosition `json:"macros"`
+	}
+
+	data.Macros = make([]MacroPosition, 0, len(ids))
+	for position, id := range ids {
+		data.Macros = append(data.Macros, MacroPosition{ID: id, Position: int64(position)})
+	}
+
+	_, err := z.Put(ctx, "/macros/update_many.json", data)
+
+	return err
+}
@@ -0,0 +1,23 @@
+package zendeskapi
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestUpdateMacroPositions(t *testing.T) {
+	client, received := newTestClient(t, http.StatusOK, `{"macros":[{"id":2,"position":0},{"id":1,"position":1}]}`)
+
+	if err := client.UpdateMacroPositions(t.Context(), []int64{2, 1}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPut,
+		path:   "/api/v2/macros/update_many.json",
+		body:   `{"macros":[{"id":2,"position":0},{"id":1,"position":1}]}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+}
@@ -0,0 +1,91 @@
+package zendeskapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+// organizationPayload sends empty external_id, details and notes, which go-zendesk omits, so
+// they are cleared. An unset group_id is sent as null instead of 0. The fields shadow the
+// ones of the embedded organization.
+type organizationPayload struct {
+	zendesk.Organization
+	ExternalID string `json:"external_id"`
+	Details    string `json:"details"`
+	Notes      string `json:"notes"`
+	GroupID    *int64 `json:"group_id"`
+}
+
+func newOrganizationPayload(organization zendesk.Organization) organizationPayload {
+	payload := organizationPayload{
+		Organization: organization,
+		ExternalID:   organization.ExternalID,
+		Details:      organization.Details,
+		Notes:        organization.Notes,
+	}
+
+	if organization.GroupID != 0 {
+		payload.GroupID = &organization.GroupID
+	}
+
+	return payload
+}
+
+type OrganizationAPI interface {
+	CreateOrganization(ctx context.Context, organization zendesk.Organization) (zendesk.Organization, error)
+	UpdateOrganization(ctx context.Context, id int64, organization zendesk.Organization) (zendesk.Organization, error)
+}
+
+var _ OrganizationAPI = &Client{}
+
+// CreateOrganization creates an organization, empty attributes are sent explicitly.
+func (z *Client) CreateOrganization(ctx context.Context, organization zendesk.Organization) (zendesk.Organization, error) {
+	var data struct {
+		Organization organizationPayload `json:"organization"`
+	}
+	var result struct {
+		Organization zendesk.Organization `json:"organization"`
+	}
+
+	data.Organization = newOrganizationPayload(organization)
+
+	body, err := z.Post(ctx, "/organizations.json", data)
+	if err != nil {
+		return zendesk.Organization{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return zendesk.Organization{}, err
+	}
+
+	return result.Organization, nil
+}
+
+// UpdateOrganization updates an organization, empty attributes are sent explicitly so they are
+// cleared.
+func (z *Client) UpdateOrganization(ctx context.Context, id int64, organization zendesk.Organization) (zendesk.Organization, error) {
+	var data struct {
+		Organization organizationPayload `json:"organization"`
+	}
+	var result struct {
+		Organization zendesk.Organization `json:"organization"`
+	}
+
+	data.Organization = newOrganizationPayload(organization)
+
+	body, err := z.Put(ctx, fmt.Sprintf("/organizations/%d.json", id), data)
+	if err != nil {
+		return zendesk.Organization{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return zendesk.Organization{}, err
+	}
+
+	return result.Organization, nil
+}
@@ -0,0 +1,54 @@
+package zendeskapi
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+func TestUpdateOrganization(t *testing.T) {
+	cases := []struct {
+		testName     string
+		organization zendesk.Organization
+		expected     []string
+	}{
+		{
+			testName:     "should send empty attributes and a null group",
+			organization: zendesk.Organization{Name: "Acme"},
+			expected:     []string{`"external_id":""`, `"details":""`, `"notes":""`, `"group_id":null`},
+		},
+		{
+			testName:     "should send set attributes",
+			organization: zendesk.Organization{Name: "Acme", ExternalID: "acme", Details: "HQ", Notes: "VIP", GroupID: 3},
+			expected:     []string{`"external_id":"acme"`, `"details":"HQ"`, `"notes":"VIP"`, `"group_id":3`},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			client, received := newTestClient(t, http.StatusOK, `{"organization":{"id":1,"name":"Acme"}}`)
+
+			organization, err := client.UpdateOrganization(t.Context(), 1, c.organization)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if received.method != http.MethodPut || received.path != "/api/v2/organizations/1.json" {
+				t.Fatalf("unexpected request %+v", *received)
+			}
+
+			for _, expected := range c.expected {
+				field, _, _ := strings.Cut(expected, ":")
+				if strings.Count(received.body, field) != 1 || !strings.Contains(received.body, expected) {
+					t.Fatalf("expected %s, got %s", expected, received.body)
+				}
+			}
+
+			if organization.ID != 1 {
+				t.Fatalf("unexpected organization %+v", organization)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package zendeskapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+// RoutingAttribute is a skill type of skills-based routing, ex: Language. Its IDs are UUIDs.
+// https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/
+type RoutingAttribute struct {
+	ID        string     `json:"id,omitempty"`
+	URL       string     `json:"url,omitempty"`
+	Name      string     `json:"name"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// RoutingAttributeValue is a skill of a routing attribute, ex: French. Tickets matching its
+// conditions are given the skill.
+type RoutingAttributeValue struct {
+	ID         string             `json:"id,omitempty"`
+	URL        string             `json:"url,omitempty"`
+	Name       string             `json:"name"`
+	Conditions *RoutingConditions `json:"conditions,omitempty"`
+	CreatedAt  *time.Time         `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time         `json:"updated_at,omitempty"`
+}
+
+// RoutingCondition is a condition of the routing API, which names the condition field subject.
+type RoutingCondition struct {
+	Subject  string              `json:"subject"`
+	Operator string              `json:"operator,omitempty"`
+	Value    zendesk.ParsedValue `json:"value,omitempty"`
+}
+
+// RoutingConditions holds the conditions of routing attribute values and queues.
+type RoutingConditions struct {
+	All []RoutingCondition `json:"all"`
+	Any []RoutingCondition `json:"any"`
+}
+
+// NewRoutingConditions converts trigger conditions to the routing API format.
+func NewRoutingConditions(conditions zendesk.Conditions) *RoutingConditions {
+	return &RoutingConditions{
+		All: newRoutingConditionList(conditions.All),
+		Any: newRoutingConditionList(conditions.Any),
+	}
+}
+
+// Conditions converts the routing conditions back to trigger conditions.
+func (c RoutingConditions) Conditions() zendesk.Conditions {
+	return zendesk.Conditions{
+		All: conditionList(c.All),
+		Any: conditionList(c.Any),
+	}
+}
+
+func newRoutingConditionList(conditions []zendesk.Condition) []RoutingCondition {
+	routingConditions := make([]RoutingCondition, len(conditions))
+
+	for i, condition := range conditions {
+		routingConditions[i] = RoutingCondition{
+			Subject:  condition.Field,
+			Operator: condition.Operator,
+			Value:    condition.Value,
+		}
+	}
+
+	return routingConditions
+}
+
+func conditionList(routingConditions []RoutingCondition) []zendesk.Condition {
+	if len(routingConditions) == 0 {
+		return nil
+	}
+
+	conditions := make([]zendesk.Condition, len(routingConditions))
+
+	for i, condition := range routingConditions {
+		conditions[i] = zendesk.Condition{
+			Field:    condition.Subject,
+			Operator: condition.Operator,
+			Value:    condition.Value,
+		}
+	}
+
+	return conditions
+}
+
+type RoutingAttributeAPI interface {
+	GetRoutingAttributes(ctx context.Context) ([]RoutingAttribute, error)
+	GetRoutingAttribute(ctx context.Context, id string) (RoutingAttribute, error)
+	CreateRoutingAttribute(ctx context.Context, attribute RoutingAttribute) (RoutingAttribute, error)
+	UpdateRoutingAttribute(ctx context.Context, id string, attribute RoutingAttribute) (RoutingAttribute, error)
+	DeleteRoutingAttribute(ctx context.Context, id string) error
+	GetRoutingAttributeValues(ctx context.Context, attributeID string) ([]RoutingAttributeValue, error)
+	GetRoutingAttributeValue(ctx context.Context, attributeID, id string) (RoutingAttributeValue, error)
+	CreateRoutingAttributeValue(ctx context.Context, attributeID string, value RoutingAttributeValue) (RoutingAttributeValue, error)
+	UpdateRoutingAttributeValue(ctx context.Context, attributeID, id string, value RoutingAttributeValue) (RoutingAttributeValue, error)
+	DeleteRoutingAttributeValue(ctx context.Context, attributeID, id string) error
+}
+
+var _ RoutingAttributeAPI = &Client{}
+
+func (z *Client) GetRoutingAttributes(ctx context.Context) ([]RoutingAttribute, error) {
+	var result struct {
+		Attributes []RoutingAttribute `json:"attributes"`
+	}
+
+	body, err := z.Get(ctx, "/routing/attributes.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Attributes, nil
+}
+
+func (z *Client) GetRoutingAttribute(ctx context.Context, id string) (RoutingAttribute, error) {
+	var result struct {
+		Attribute RoutingAttribute `json:"attribute"`
+	}
+
+	body, err := z.Get(ctx, fmt.Sprintf("/routing/attributes/%s.json", id))
+	if err != nil {
+		return RoutingAttribute{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return RoutingAttribute{}, err
+	}
+
+	return result.Attribute, nil
+}
+
+func (z *Client) CreateRoutingAttribute(ctx context.Context, attribute RoutingAttribute) (RoutingAttribute, error) {
+	var data, result struct {
+		Attribute RoutingAttribute `json:"attribute"`
+	}
+
+	data.Attribute = attribute
+
+	body, err := z.Post(ctx, "/routing/attributes.json", data)
+	if err != nil {
+		return RoutingAttribute{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return RoutingAttribute{}, err
+	}
+
+	return result.Attribute, nil
+}
+
+func (z *Client) UpdateRoutingAttribute(ctx context.Context, id string, attribute RoutingAttribute) (RoutingAttribute, error) {
+	var data, result struct {
+		Attribute RoutingAttribute `json:"attribute"`
+	}
+
+	data.Attribute = attribute
+	data.Attribute.ID = ""
+
+	body, err := z.Put(ctx, fmt.Sprintf("/routing/attributes/%s.json", id), data)
+	if err != nil {
+		return RoutingAttribute{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return RoutingAttribute{}, err
+	}
+
+	return result.Attribute, nil
+}
+
+// DeleteRoutingAttribute deletes a routing attribute along with its values.
+func (z *Client) DeleteRoutingAttribute(ctx context.Context, id string) error {
+	return z.Delete(ctx, fmt.Sprintf("/routing/attributes/%s.json", id))
+}
+
+func (z *Client) GetRoutingAttributeValues(ctx context.Context, attributeID string) ([]RoutingAttributeValue, error) {
+	var result struct {
+		AttributeValues []RoutingAttributeValue `json:"attribute_values"`
+	}
+
+	body, err := z.Get(ctx, fmt.Sprintf("/routing/attributes/%s/values.json", attributeID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.AttributeValues, nil
+}
+
+func (z *Client) GetRoutingAttributeValue(ctx context.Context, attributeID, id string) (RoutingAttributeValue, error) {
+	var result struct {
+		AttributeValue RoutingAttributeValue `json:"attribute_value"`
+	}
+
+	body, err := z.Get(ctx, fmt.Sprintf("/routing/attributes/%s/values/%s.json", attributeID, id))
+	if err != nil {
+		return RoutingAttributeValue{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return RoutingAttributeValue{}, err
+	}
+
+	return result.AttributeValue, nil
+}
+
+func (z *Client) CreateRoutingAttributeValue(ctx context.Context, attributeID string, value RoutingAttributeValue) (RoutingAttributeValue, error) {
+	var data, result struct {
+		AttributeValue RoutingAttributeValue `json:"attribute_value"`
+	}
+
+	data.AttributeValue = value
+
+	body, err := z.Post(ctx, fmt.Sprintf("/routing/attributes/%s/values.json", attributeID), data)
+	if err != nil {
+		return RoutingAttributeValue{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return RoutingAttributeValue{}, err
+	}
+
+	return result.AttributeValue, nil
+}
+
+func (z *Client) UpdateRoutingAttributeValue(ctx context.Context, attributeID, id string, value RoutingAttributeValue) (RoutingAttributeValue, error) {
+	var data, result struct {
+		AttributeValue RoutingAttributeValue `json:"attribute_value"`
+	}
+
+	data.AttributeValue = value
+	data.AttributeValue.ID = ""
+
+	body, err := z.Patch(ctx, fmt.Sprintf("/routing/attributes/%s/values/%s.json", attributeID, id), data)
+	if err != nil {
+		return RoutingAttributeValue{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return RoutingAttributeValue{}, err
+	}
+
+	return result.AttributeValue, nil
+}
+
+// DeleteRoutingAttributeValue deletes a routing attribute value, agents and tickets lose the skill.
+func (z *Client) DeleteRoutingAttributeValue(ctx context.Context, attributeID, id string) error {
+	return z.Delete(ctx, fmt.Sprintf("/routing/attributes/%s/values/%s.json", attributeID, id))
+}
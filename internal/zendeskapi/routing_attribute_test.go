@@ -0,0 +1,93 @@
+package zendeskapi
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+func TestCreateRoutingAttribute(t *testing.T) {
+	client, received := newTestClient(t, http.StatusCreated, `{"attribute":{"id":"15821cba-7326-11e8-b07e-950ba849aa27","name":"Language"}}`)
+
+	attribute, err := client.CreateRoutingAttribute(t.Context(), RoutingAttribute{Name: "Language"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPost,
+		path:   "/api/v2/routing/attributes.json",
+		body:   `{"attribute":{"name":"Language"}}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+
+	if attribute.ID != "15821cba-7326-11e8-b07e-950ba849aa27" {
+		t.Fatalf("unexpected routing attribute %+v", attribute)
+	}
+}
+
+func TestCreateRoutingAttributeValue(t *testing.T) {
+	client, received := newTestClient(t, http.StatusCreated, `{"attribute_value":{"id":"b376b35a-e38b-11e8-a292-e3b6377c5575","name":"French"}}`)
+
+	value, err := client.CreateRoutingAttributeValue(t.Context(), "15821cba-7326-11e8-b07e-950ba849aa27", RoutingAttributeValue{
+		Name: "French",
+		Conditions: NewRoutingConditions(zendesk.Conditions{
+			All: []zendesk.Condition{{Field: "locale_id", Operator: "is", Value: zendesk.ParsedValue{Data: "fr"}}},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPost,
+		path:   "/api/v2/routing/attributes/15821cba-7326-11e8-b07e-950ba849aa27/values.json",
+		body:   `{"attribute_value":{"name":"French","conditions":{"all":[{"subject":"locale_id","operator":"is","value":"fr"}],"any":[]}}}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+
+	if value.ID != "b376b35a-e38b-11e8-a292-e3b6377c5575" || value.Conditions != nil {
+		t.Fatalf("unexpected routing attribute value %+v", value)
+	}
+}
+
+func TestUpdateRoutingAttributeValue(t *testing.T) {
+	client, received := newTestClient(t, http.StatusOK, `{"attribute_value":{"id":"b376b35a-e38b-11e8-a292-e3b6377c5575","name":"German"}}`)
+
+	_, err := client.UpdateRoutingAttributeValue(t.Context(), "15821cba-7326-11e8-b07e-950ba849aa27", "b376b35a-e38b-11e8-a292-e3b6377c5575", RoutingAttributeValue{
+		ID:   "b376b35a-e38b-11e8-a292-e3b6377c5575",
+		Name: "German",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPatch,
+		path:   "/api/v2/routing/attributes/15821cba-7326-11e8-b07e-950ba849aa27/values/b376b35a-e38b-11e8-a292-e3b6377c5575.json",
+		body:   `{"attribute_value":{"name":"German"}}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+}
+
+func TestRoutingConditions_Conditions(t *testing.T) {
+	routingConditions := RoutingConditions{
+		Any: []RoutingCondition{{Subject: "via_id", Operator: "is", Value: zendesk.ParsedValue{Data: "4"}}},
+	}
+
+	expected := zendesk.Conditions{
+		Any: []zendesk.Condition{{Field: "via_id", Operator: "is", Value: zendesk.ParsedValue{Data: "4"}}},
+	}
+
+	if out := routingConditions.Conditions(); !reflect.DeepEqual(out, expected) {
+		t.Fatalf("expected conditions %+v, got %+v", expected, out)
+	}
+}
@@ -0,0 +1,171 @@
+package zendeskapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// RoutingQueue is an omnichannel routing queue, work items matching its definition are offered
+// to the agents of its primary groups, then of its secondary groups. Its IDs are ULIDs.
+// The group IDs are sent as primary_groups_id and secondary_groups_id, and returned as groups.
+// https://developer.zendesk.com/api-reference/agent-availability/omnichannel-routing-queues/
+type RoutingQueue struct {
+	ID                string              `json:"id,omitempty"`
+	URL               string              `json:"url,omitempty"`
+	Name              string              `json:"name"`
+	Description       string              `json:"description"`
+	Priority          int64               `json:"priority"`
+	Order             int64               `json:"order,omitempty"`
+	Definition        *RoutingConditions  `json:"definition,omitempty"`
+	PrimaryGroupIDs   []int64             `json:"primary_groups_id"`
+	SecondaryGroupIDs []int64             `json:"secondary_groups_id"`
+	PrimaryGroups     *RoutingQueueGroups `json:"primary_groups,omitempty"`
+	SecondaryGroups   *RoutingQueueGroups `json:"secondary_groups,omitempty"`
+	CreatedAt         *time.Time          `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time          `json:"updated_at,omitempty"`
+}
+
+// RoutingQueueGroups lists the groups of a routing queue.
+type RoutingQueueGroups struct {
+	Count  int64               `json:"count"`
+	Groups []RoutingQueueGroup `json:"groups"`
+}
+
+type RoutingQueueGroup struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// IDs returns the IDs of the groups, nil groups have none.
+func (g *RoutingQueueGroups) IDs() []int64 {
+	if g == nil {
+		return []int64{}
+	}
+
+	ids := make([]int64, len(g.Groups))
+
+	for i, group := range g.Groups {
+		ids[i] = group.ID
+	}
+
+	return ids
+}
+
+type RoutingQueueAPI interface {
+	GetRoutingQueues(ctx context.Context) ([]RoutingQueue, error)
+	GetRoutingQueue(ctx context.Context, id string) (RoutingQueue, error)
+	CreateRoutingQueue(ctx context.Context, queue RoutingQueue) (RoutingQueue, error)
+	UpdateRoutingQueue(ctx context.Context, id string, queue RoutingQueue) (RoutingQueue, error)
+	ReorderRoutingQueues(ctx context.Context, ids []string) error
+	DeleteRoutingQueue(ctx context.Context, id string) error
+}
+
+var _ RoutingQueueAPI = &Client{}
+
+// GetRoutingQueues returns every routing queue, in their routing order.
+func (z *Client) GetRoutingQueues(ctx context.Context) ([]RoutingQueue, error) {
+	var result struct {
+		Queues []RoutingQueue `json:"queues"`
+	}
+
+	body, err := z.Get(ctx, "/queues.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Queues, nil
+}
+
+func (z *Client) GetRoutingQueue(ctx context.Context, id string) (RoutingQueue, error) {
+	var result struct {
+		Queue RoutingQueue `json:"queue"`
+	}
+
+	body, err := z.Get(ctx, fmt.Sprintf("/queues/%s.json", id))
+	if err != nil {
+		return RoutingQueue{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return RoutingQueue{}, err
+	}
+
+	return result.Queue, nil
+}
+
+// CreateRoutingQueue creates a routing queue, which is added last in the routing order.
+func (z *Client) CreateRoutingQueue(ctx context.Context, queue RoutingQueue) (RoutingQueue, error) {
+	var data, result struct {
+		Queue RoutingQueue `json:"queue"`
+	}
+
+	data.Queue = routingQueuePayload(queue)
+
+	body, err := z.Post(ctx, "/queues.json", data)
+	if err != nil {
+		return RoutingQueue{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return RoutingQueue{}, err
+	}
+
+	return result.Queue, nil
+}
+
+func (z *Client) UpdateRoutingQueue(ctx context.Context, id string, queue RoutingQueue) (RoutingQueue, error) {
+	var data, result struct {
+		Queue RoutingQueue `json:"queue"`
+	}
+
+	data.Queue = routingQueuePayload(queue)
+
+	body, err := z.Put(ctx, fmt.Sprintf("/queues/%s.json", id), data)
+	if err != nil {
+		return RoutingQueue{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return RoutingQueue{}, err
+	}
+
+	return result.Queue, nil
+}
+
+// ReorderRoutingQueues sets the routing order of the queues, ids must list every queue.
+func (z *Client) ReorderRoutingQueues(ctx context.Context, ids []string) error {
+	data := struct {
+		QueueIDs []string `json:"queue_ids"`
+	}{QueueIDs: ids}
+
+	_, err := z.Patch(ctx, "/queues/order.json", data)
+
+	return err
+}
+
+func (z *Client) DeleteRoutingQueue(ctx context.Context, id string) error {
+	return z.Delete(ctx, fmt.Sprintf("/queues/%s.json", id))
+}
+
+// routingQueuePayload keeps the writable fields of a queue, its order is only set by
+// ReorderRoutingQueues.
+func routingQueuePayload(queue RoutingQueue) RoutingQueue {
+	return RoutingQueue{
+		Name:              queue.Name,
+		Description:       queue.Description,
+		Priority:          queue.Priority,
+		Definition:        queue.Definition,
+		PrimaryGroupIDs:   queue.PrimaryGroupIDs,
+		SecondaryGroupIDs: queue.SecondaryGroupIDs,
+	}
+}
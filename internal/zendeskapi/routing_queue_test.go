@@ -0,0 +1,59 @@
+package zendeskapi
+
+import (
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+func TestCreateRoutingQueue(t *testing.T) {
+	client, received := newTestClient(t, http.StatusCreated, `{"queue":{"id":"01HG80ATNNZK1N7XRFVKX48XD6","name":"Premium","priority":2,"order":3,"primary_groups":{"count":1,"groups":[{"id":1,"name":"Tier 2"}]},"secondary_groups":{"count":0,"groups":[]}}}`)
+
+	queue, err := client.CreateRoutingQueue(t.Context(), RoutingQueue{
+		ID:       "ignored",
+		Name:     "Premium",
+		Priority: 2,
+		Order:    1,
+		Definition: NewRoutingConditions(zendesk.Conditions{
+			All: []zendesk.Condition{{Field: "priority", Operator: "is", Value: zendesk.ParsedValue{Data: "urgent"}}},
+		}),
+		PrimaryGroupIDs:   []int64{1},
+		SecondaryGroupIDs: []int64{},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPost,
+		path:   "/api/v2/queues.json",
+		body:   `{"queue":{"name":"Premium","description":"","priority":2,"definition":{"all":[{"subject":"priority","operator":"is","value":"urgent"}],"any":[]},"primary_groups_id":[1],"secondary_groups_id":[]}}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+
+	if queue.Order != 3 || !slices.Equal(queue.PrimaryGroups.IDs(), []int64{1}) || len(queue.SecondaryGroups.IDs()) != 0 {
+		t.Fatalf("unexpected routing queue %+v", queue)
+	}
+}
+
+func TestReorderRoutingQueues(t *testing.T) {
+	client, received := newTestClient(t, http.StatusOK, `{}`)
+
+	err := client.ReorderRoutingQueues(t.Context(), []string{"01HG80ATNNZK1N7XRFVKX48XD6", "01HG80ATNNZK1N7XRFVKX48XD7"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPatch,
+		path:   "/api/v2/queues/order.json",
+		body:   `{"queue_ids":["01HG80ATNNZK1N7XRFVKX48XD6","01HG80ATNNZK1N7XRFVKX48XD7"]}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+}
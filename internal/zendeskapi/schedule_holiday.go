@@ -0,0 +1,107 @@
+package zendeskapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ScheduleHoliday is a holiday of a business hours schedule, dates are formatted as
+// YYYY-MM-DD.
+// https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#holidays
+type ScheduleHoliday struct {
+	ID        int64  `json:"id,omitempty"`
+	Name      string `json:"name"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+type ScheduleHolidayAPI interface {
+	GetScheduleHolidays(ctx context.Context, scheduleID int64) ([]ScheduleHoliday, error)
+	GetScheduleHoliday(ctx context.Context, scheduleID, holidayID int64) (ScheduleHoliday, error)
+	CreateScheduleHoliday(ctx context.Context, scheduleID int64, holiday ScheduleHoliday) (ScheduleHoliday, error)
+	UpdateScheduleHoliday(ctx context.Context, scheduleID, holidayID int64, holiday ScheduleHoliday) (ScheduleHoliday, error)
+	DeleteScheduleHoliday(ctx context.Context, scheduleID, holidayID int64) error
+}
+
+var _ ScheduleHolidayAPI = &Client{}
+
+func (z *Client) GetScheduleHolidays(ctx context.Context, scheduleID int64) ([]ScheduleHoliday, error) {
+	var result struct {
+		Holidays []ScheduleHoliday `json:"holidays"`
+	}
+
+	body, err := z.Get(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays.json", scheduleID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Holidays, nil
+}
+
+func (z *Client) GetScheduleHoliday(ctx context.Context, scheduleID, holidayID int64) (ScheduleHoliday, error) {
+	var result struct {
+		Holiday ScheduleHoliday `json:"holiday"`
+	}
+
+	body, err := z.Get(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays/%d.json", scheduleID, holidayID))
+	if err != nil {
+		return ScheduleHoliday{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ScheduleHoliday{}, err
+	}
+
+	return result.Holiday, nil
+}
+
+func (z *Client) CreateScheduleHoliday(ctx context.Context, scheduleID int64, holiday ScheduleHoliday) (ScheduleHoliday, error) {
+	var data, result struct {
+		Holiday ScheduleHoliday `json:"holiday"`
+	}
+
+	data.Holiday = holiday
+
+	body, err := z.Post(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays.json", scheduleID), data)
+	if err != nil {
+		return ScheduleHoliday{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ScheduleHoliday{}, err
+	}
+
+	return result.Holiday, nil
+}
+
+func (z *Client) UpdateScheduleHoliday(ctx context.Context, scheduleID, holidayID int64, holiday ScheduleHoliday) (ScheduleHoliday, error) {
+	var data, result struct {
+		Holiday ScheduleHoliday `json:"holiday"`
+	}
+
+	data.Holiday = holiday
+
+	body, err := z.Put(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays/%d.json", scheduleID, holidayID), data)
+	if err != nil {
+		return ScheduleHoliday{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ScheduleHoliday{}, err
+	}
+
+	return result.Holiday, nil
+}
+
+func (z *Client) DeleteScheduleHoliday(ctx context.Context, scheduleID, holidayID int64) error {
+	return z.Delete(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays/%d.json", scheduleID, holidayID))
+}
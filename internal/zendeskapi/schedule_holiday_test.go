@@ -0,0 +1,48 @@
+package zendeskapi
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCreateScheduleHoliday(t *testing.T) {
+	client, received := newTestClient(t, http.StatusCreated,
+		`{"holiday":{"id":2,"name":"Christmas","start_date":"2030-12-25","end_date":"2030-12-26"}}`)
+
+	holiday, err := client.CreateScheduleHoliday(t.Context(), 1, ScheduleHoliday{
+		Name:      "Christmas",
+		StartDate: "2030-12-25",
+		EndDate:   "2030-12-26",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPost,
+		path:   "/api/v2/business_hours/schedules/1/holidays.json",
+		body:   `{"holiday":{"name":"Christmas","start_date":"2030-12-25","end_date":"2030-12-26"}}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+
+	expected := ScheduleHoliday{ID: 2, Name: "Christmas", StartDate: "2030-12-25", EndDate: "2030-12-26"}
+	if !reflect.DeepEqual(holiday, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, holiday)
+	}
+}
+
+func TestGetScheduleHoliday_NotFound(t *testing.T) {
+	client, received := newTestClient(t, http.StatusNotFound, `{"error":"RecordNotFound"}`)
+
+	_, err := client.GetScheduleHoliday(t.Context(), 1, 2)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if received.path != "/api/v2/business_hours/schedules/1/holidays/2.json" {
+		t.Fatalf("unexpected path %s", received.path)
+	}
+}
@@ -0,0 +1,153 @@
+package zendeskapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+const (
+	// SupportAddressStatusVerified is the status of checks which succeeded.
+	SupportAddressStatusVerified = "verified"
+	// SupportAddressStatusFailed is the status of checks which failed.
+	SupportAddressStatusFailed = "failed"
+)
+
+// SupportAddress is an email address tickets are received on, also called recipient address.
+// Its email can't be changed once created, the statuses report the forwarding and DNS checks.
+// https://developer.zendesk.com/api-reference/ticketing/account-configuration/support_addresses/
+type SupportAddress struct {
+	ID                       int64      `json:"id,omitempty"`
+	Email                    string     `json:"email,omitempty"`
+	Name                     string     `json:"name"`
+	BrandID                  int64      `json:"brand_id,omitempty"`
+	Default                  bool       `json:"default,omitempty"`
+	ForwardingStatus         string     `json:"forwarding_status,omitempty"`
+	SPFStatus                string     `json:"spf_status,omitempty"`
+	CNAMEStatus              string     `json:"cname_status,omitempty"`
+	DomainVerificationStatus string     `json:"domain_verification_status,omitempty"`
+	CreatedAt                *time.Time `json:"created_at,omitempty"`
+	UpdatedAt                *time.Time `json:"updated_at,omitempty"`
+}
+
+type SupportAddressAPI interface {
+	GetSupportAddresses(ctx context.Context) ([]SupportAddress, error)
+	GetSupportAddress(ctx context.Context, addressID int64) (SupportAddress, error)
+	CreateSupportAddress(ctx context.Context, address SupportAddress) (SupportAddress, error)
+	UpdateSupportAddress(ctx context.Context, addressID int64, address SupportAddress) (SupportAddress, error)
+	VerifySupportAddressForwarding(ctx context.Context, addressID int64) error
+	DeleteSupportAddress(ctx context.Context, addressID int64) error
+}
+
+var _ SupportAddressAPI = &Client{}
+
+// GetSupportAddresses returns every support address, following pagination.
+func (z *Client) GetSupportAddresses(ctx context.Context) ([]SupportAddress, error) {
+	var addresses []SupportAddress
+
+	for page := 1; ; page++ {
+		var result struct {
+			RecipientAddresses []SupportAddress `json:"recipient_addresses"`
+			zendesk.Page
+		}
+
+		body, err := z.Get(ctx, fmt.Sprintf("/recipient_addresses.json?page=%d&per_page=100", page))
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		addresses = append(addresses, result.RecipientAddresses...)
+
+		if !result.HasNext() {
+			return addresses, nil
+		}
+	}
+}
+
+func (z *Client) GetSupportAddress(ctx context.Context, addressID int64) (SupportAddress, error) {
+	var result struct {
+		RecipientAddress SupportAddress `json:"recipient_address"`
+	}
+
+	body, err := z.Get(ctx, fmt.Sprintf("/recipient_addresses/%d.json", addressID))
+	if err != nil {
+		return SupportAddress{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SupportAddress{}, err
+	}
+
+	return result.RecipientAddress, nil
+}
+
+func (z *Client) CreateSupportAddress(ctx context.Context, address SupportAddress) (SupportAddress, error) {
+	var data, result struct {
+		RecipientAddress SupportAddress `json:"recipient_address"`
+	}
+
+	data.RecipientAddress = address
+
+	body, err := z.Post(ctx, "/recipient_addresses.json", data)
+	if err != nil {
+		return SupportAddress{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SupportAddress{}, err
+	}
+
+	return result.RecipientAddress, nil
+}
+
+// UpdateSupportAddress updates the name, brand and default flag of a support address, its
+// email can't be changed.
+func (z *Client) UpdateSupportAddress(ctx context.Context, addressID int64, address SupportAddress) (SupportAddress, error) {
+	var data, result struct {
+		RecipientAddress SupportAddress `json:"recipient_address"`
+	}
+
+	data.RecipientAddress = SupportAddress{
+		Name:    address.Name,
+		BrandID: address.BrandID,
+		Default: address.Default,
+	}
+
+	body, err := z.Put(ctx, fmt.Sprintf("/recipient_addresses/%d.json", addressID), data)
+	if err != nil {
+		return SupportAddress{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SupportAddress{}, err
+	}
+
+	return result.RecipientAddress, nil
+}
+
+// VerifySupportAddressForwarding sends a test email to the address, Zendesk updates the
+// forwarding status once the email is received or the check times out.
+func (z *Client) VerifySupportAddressForwarding(ctx context.Context, addressID int64) error {
+	data := struct {
+		Type string `json:"type"`
+	}{Type: "forwarding"}
+
+	_, err := z.Put(ctx, fmt.Sprintf("/recipient_addresses/%d/verify.json", addressID), data)
+
+	return err
+}
+
+func (z *Client) DeleteSupportAddress(ctx context.Context, addressID int64) error {
+	return z.Delete(ctx, fmt.Sprintf("/recipient_addresses/%d.json", addressID))
+}
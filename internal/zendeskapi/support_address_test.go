@@ -0,0 +1,74 @@
+package zendeskapi
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestCreateSupportAddress(t *testing.T) {
+	client, received := newTestClient(t, http.StatusCreated, `{"recipient_address":{"id":1,"email":"help@example.com","name":"Support","brand_id":2,"forwarding_status":"waiting","spf_status":"unknown"}}`)
+
+	address, err := client.CreateSupportAddress(t.Context(), SupportAddress{
+		Email:   "help@example.com",
+		Name:    "Support",
+		BrandID: 2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPost,
+		path:   "/api/v2/recipient_addresses.json",
+		body:   `{"recipient_address":{"email":"help@example.com","name":"Support","brand_id":2}}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+
+	if address.ID != 1 || address.ForwardingStatus != "waiting" {
+		t.Fatalf("unexpected support address %+v", address)
+	}
+}
+
+func TestUpdateSupportAddress(t *testing.T) {
+	client, received := newTestClient(t, http.StatusOK, `{"recipient_address":{"id":1,"email":"help@example.com","name":"Help","default":true}}`)
+
+	_, err := client.UpdateSupportAddress(t.Context(), 1, SupportAddress{
+		Email:            "help@example.com",
+		Name:             "Help",
+		BrandID:          2,
+		Default:          true,
+		ForwardingStatus: "verified",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPut,
+		path:   "/api/v2/recipient_addresses/1.json",
+		body:   `{"recipient_address":{"name":"Help","brand_id":2,"default":true}}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+}
+
+func TestVerifySupportAddressForwarding(t *testing.T) {
+	client, received := newTestClient(t, http.StatusOK, ``)
+
+	err := client.VerifySupportAddressForwarding(t.Context(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPut,
+		path:   "/api/v2/recipient_addresses/1/verify.json",
+		body:   `{"type":"forwarding"}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+}
@@ -0,0 +1,95 @@
+package zendeskapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+// ticketFormPayload sends in_all_brands false, which go-zendesk omits. The field shadows the one of
+// the embedded form.
+type ticketFormPayload struct {
+	zendesk.TicketForm
+	InAllBrands *bool `json:"in_all_brands,omitempty"`
+}
+
+// newTicketFormPayload sends the configured in_all_brands, nil omits it. A form restricted to
+// brands is not in all brands.
+func newTicketFormPayload(form zendesk.TicketForm, inAllBrands *bool) ticketFormPayload {
+	payload := ticketFormPayload{TicketForm: form, InAllBrands: inAllBrands}
+
+	if len(form.RestrictedBrandIds) > 0 {
+		payload.InAllBrands = new(bool)
+	}
+
+	return payload
+}
+
+type TicketFormAPI interface {
+	CreateTicketForm(ctx context.Context, form zendesk.TicketForm, inAllBrands *bool) (zendesk.TicketForm, error)
+	UpdateTicketForm(ctx context.Context, id int64, form zendesk.TicketForm, inAllBrands *bool) (zendesk.TicketForm, error)
+	ReorderTicketForms(ctx context.Context, ids []int64) error
+}
+
+var _ TicketFormAPI = &Client{}
+
+// CreateTicketForm creates a ticket form with the configured in_all_brands, see newTicketFormPayload.
+func (z *Client) CreateTicketForm(ctx context.Context, form zendesk.TicketForm, inAllBrands *bool) (zendesk.TicketForm, error) {
+	var data struct {
+		TicketForm ticketFormPayload `json:"ticket_form"`
+	}
+	var result struct {
+		TicketForm zendesk.TicketForm `json:"ticket_form"`
+	}
+
+	data.TicketForm = newTicketFormPayload(form, inAllBrands)
+
+	body, err := z.Post(ctx, "/ticket_forms.json", data)
+	if err != nil {
+		return zendesk.TicketForm{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return zendesk.TicketForm{}, err
+	}
+
+	return result.TicketForm, nil
+}
+
+// UpdateTicketForm updates a ticket form with the configured in_all_brands, see newTicketFormPayload.
+func (z *Client) UpdateTicketForm(ctx context.Context, id int64, form zendesk.TicketForm, inAllBrands *bool) (zendesk.TicketForm, error) {
+	var data struct {
+		TicketForm ticketFormPayload `json:"ticket_form"`
+	}
+	var result struct {
+		TicketForm zendesk.TicketForm `json:"ticket_form"`
+	}
+
+	data.TicketForm = newTicketFormPayload(form, inAllBrands)
+
+	body, err := z.Put(ctx, fmt.Sprintf("/ticket_forms/%d.json", id), data)
+	if err != nil {
+		return zendesk.TicketForm{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return zendesk.TicketForm{}, err
+	}
+
+	return result.TicketForm, nil
+}
+
+// ReorderTicketForms sets the position of every ticket form from its index in ids.
+func (z *Client) ReorderTicketForms(ctx context.Context, ids []int64) error {
+	data := struct {
+		TicketFormIDs []int64 `json:"ticket_form_ids"`
+	}{TicketFormIDs: ids}
+
+	_, err := z.Put(ctx, "/ticket_forms/reorder.json", data)
+
+	return err
+}
@@ -0,0 +1,85 @@
+package zendeskapi
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+func TestCreateTicketForm(t *testing.T) {
+	inAllBrands := true
+
+	cases := []struct {
+		testName            string
+		form                zendesk.TicketForm
+		inAllBrands         *bool
+		expectedInAllBrands string
+	}{
+		{
+			testName:            "should send in_all_brands false for forms restricted to brands",
+			form:                zendesk.TicketForm{Name: "Returns", RestrictedBrandIds: []int64{1, 2}},
+			expectedInAllBrands: `"in_all_brands":false`,
+		},
+		{
+			testName:            "should send in_all_brands true",
+			form:                zendesk.TicketForm{Name: "Returns", InAllBrands: true},
+			inAllBrands:         &inAllBrands,
+			expectedInAllBrands: `"in_all_brands":true`,
+		},
+		{
+			testName:            "should send in_all_brands false when it is configured without brands",
+			form:                zendesk.TicketForm{Name: "Returns"},
+			inAllBrands:         new(bool),
+			expectedInAllBrands: `"in_all_brands":false`,
+		},
+		{
+			testName: "should omit in_all_brands when the brands are not set",
+			form:     zendesk.TicketForm{Name: "Returns"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			client, received := newTestClient(t, http.StatusCreated, `{"ticket_form":{"id":1,"name":"Returns","restricted_brand_ids":[1,2]}}`)
+
+			form, err := client.CreateTicketForm(t.Context(), c.form, c.inAllBrands)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if received.method != http.MethodPost || received.path != "/api/v2/ticket_forms.json" {
+				t.Fatalf("unexpected request %+v", *received)
+			}
+
+			if c.expectedInAllBrands == "" {
+				if strings.Contains(received.body, "in_all_brands") {
+					t.Fatalf("expected in_all_brands to be omitted, got %s", received.body)
+				}
+			} else if strings.Count(received.body, "in_all_brands") != 1 || !strings.Contains(received.body, c.expectedInAllBrands) {
+				t.Fatalf("expected %s, got %s", c.expectedInAllBrands, received.body)
+			}
+
+			if form.ID != 1 || len(form.RestrictedBrandIds) != 2 {
+				t.Fatalf("unexpected ticket form %+v", form)
+			}
+		})
+	}
+}
+
+func TestReorderTicketForms(t *testing.T) {
+	client, received := newTestClient(t, http.StatusOK, `{"ticket_forms":[{"id":2,"position":0},{"id":1,"position":1}]}`)
+
+	if err := client.ReorderTicketForms(t.Context(), []int64{2, 1}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if received.method != http.MethodPut || received.path != "/api/v2/ticket_forms/reorder.json" {
+		t.Fatalf("unexpected request %+v", *received)
+	}
+
+	if received.body != `{"ticket_form_ids":[2,1]}` {
+		t.Fatalf("unexpected body %s", received.body)
+	}
+}
@@ -0,0 +1,135 @@
+package zendeskapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+// TriggerCategoryJobStatusCompleted is the status of batch jobs which succeeded.
+const TriggerCategoryJobStatusCompleted = "completed"
+
+// TriggerCategoryJobItem sets the position of a trigger category, or of a trigger and its
+// category. The batch jobs API takes every ID as a string.
+type TriggerCategoryJobItem struct {
+	ID         string `json:"id"`
+	Position   int64  `json:"position"`
+	CategoryID string `json:"category_id,omitempty"`
+}
+
+// TriggerCategoryJobItems are the trigger categories and triggers updated by a batch job.
+type TriggerCategoryJobItems struct {
+	TriggerCategories []TriggerCategoryJobItem `json:"trigger_categories,omitempty"`
+	Triggers          []TriggerCategoryJobItem `json:"triggers,omitempty"`
+}
+
+// TriggerCategoryJobError is the reason a batch job failed.
+type TriggerCategoryJobError struct {
+	Title   string `json:"title"`
+	Message string `json:"message"`
+}
+
+// triggerCategoryNamePayload is a trigger category without its position, which go-zendesk
+// always sends.
+type triggerCategoryNamePayload struct {
+	TriggerCategory struct {
+		Name string `json:"name"`
+	} `json:"trigger_category"`
+}
+
+type TriggerCategoryAPI interface {
+	CreateTriggerCategoryWithoutPosition(ctx context.Context, name string) (zendesk.TriggerCategory, error)
+	UpdateTriggerCategoryName(ctx context.Context, id int64, name string) (zendesk.TriggerCategory, error)
+	RunTriggerCategoryJob(ctx context.Context, items TriggerCategoryJobItems) error
+}
+
+var _ TriggerCategoryAPI = &Client{}
+
+// CreateTriggerCategoryWithoutPosition creates a trigger category without sending a position,
+// Zendesk adds it last instead of moving it first.
+func (z *Client) CreateTriggerCategoryWithoutPosition(ctx context.Context, name string) (zendesk.TriggerCategory, error) {
+	var data triggerCategoryNamePayload
+	var result struct {
+		TriggerCategory zendesk.TriggerCategory `json:"trigger_category"`
+	}
+
+	data.TriggerCategory.Name = name
+
+	body, err := z.Post(ctx, "/trigger_categories.json", data)
+	if err != nil {
+		return zendesk.TriggerCategory{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return zendesk.TriggerCategory{}, err
+	}
+
+	return result.TriggerCategory, nil
+}
+
+// UpdateTriggerCategoryName renames a trigger category without sending its position.
+func (z *Client) UpdateTriggerCategoryName(ctx context.Context, id int64, name string) (zendesk.TriggerCategory, error) {
+	var data triggerCategoryNamePayload
+	var result struct {
+		TriggerCategory zendesk.TriggerCategory `json:"trigger_category"`
+	}
+
+	data.TriggerCategory.Name = name
+
+	body, err := z.Put(ctx, fmt.Sprintf("/trigger_categories/%d.json", id), data)
+	if err != nil {
+		return zendesk.TriggerCategory{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return zendesk.TriggerCategory{}, err
+	}
+
+	return result.TriggerCategory, nil
+}
+
+// RunTriggerCategoryJob updates the positions of trigger categories and triggers in one batch
+// job, Zendesk applies every item or none.
+func (z *Client) RunTriggerCategoryJob(ctx context.Context, items TriggerCategoryJobItems) error {
+	var data struct {
+		Job struct {
+			Action string                  `json:"action"`
+			Items  TriggerCategoryJobItems `json:"items"`
+		} `json:"job"`
+	}
+	var result struct {
+		Job struct {
+			Status string                    `json:"status"`
+			Errors []TriggerCategoryJobError `json:"errors"`
+		} `json:"job"`
+	}
+
+	data.Job.Action = "patch"
+	data.Job.Items = items
+
+	body, err := z.Post(ctx, "/trigger_categories/jobs.json", data)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return err
+	}
+
+	if result.Job.Status != TriggerCategoryJobStatusCompleted {
+		messages := make([]string, 0, len(result.Job.Errors))
+		for _, jobError := range result.Job.Errors {
+			messages = append(messages, jobError.Message)
+		}
+
+		return fmt.Errorf("trigger category job %s: %s", result.Job.Status, strings.Join(messages, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package zendeskapi
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestCreateTriggerCategoryWithoutPosition(t *testing.T) {
+	client, received := newTestClient(t, http.StatusCreated, `{"trigger_category":{"id":"10001","name":"Notifications","position":3}}`)
+
+	category, err := client.CreateTriggerCategoryWithoutPosition(t.Context(), "Notifications")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPost,
+		path:   "/api/v2/trigger_categories.json",
+		body:   `{"trigger_category":{"name":"Notifications"}}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+
+	if category.ID != "10001" || category.Position != 3 {
+		t.Fatalf("unexpected trigger category %+v", category)
+	}
+}
+
+func TestUpdateTriggerCategoryName(t *testing.T) {
+	client, received := newTestClient(t, http.StatusOK, `{"trigger_category":{"id":"10001","name":"Notifications","position":3}}`)
+
+	category, err := client.UpdateTriggerCategoryName(t.Context(), 10001, "Notifications")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPut,
+		path:   "/api/v2/trigger_categories/10001.json",
+		body:   `{"trigger_category":{"name":"Notifications"}}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+
+	if category.ID != "10001" || category.Position != 3 {
+		t.Fatalf("unexpected trigger category %+v", category)
+	}
+}
+
+func TestRunTriggerCategoryJob(t *testing.T) {
+	items := TriggerCategoryJobItems{
+		TriggerCategories: []TriggerCategoryJobItem{{ID: "10001", Position: 0}},
+		Triggers:          []TriggerCategoryJobItem{{ID: "20001", Position: 0, CategoryID: "10001"}},
+	}
+
+	cases := []struct {
+		testName      string
+		response      string
+		expectedError string
+	}{
+		{
+			testName: "should submit a patch job",
+			response: `{"job":{"status":"completed","results":{}}}`,
+		},
+		{
+			testName:      "should return the errors of a failed job",
+			response:      `{"job":{"status":"failed","errors":[{"title":"Invalid","message":"Trigger 20001 not found"}]}}`,
+			expectedError: "trigger category job failed: Trigger 20001 not found",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			client, received := newTestClient(t, http.StatusOK, c.response)
+
+			err := client.RunTriggerCategoryJob(t.Context(), items)
+
+			expectedRequest := testRequest{
+				method: http.MethodPost,
+				path:   "/api/v2/trigger_categories/jobs.json",
+				body:   `{"job":{"action":"patch","items":{"trigger_categories":[{"id":"10001","position":0}],"triggers":[{"id":"20001","position":0,"category_id":"10001"}]}}}`,
+			}
+			if *received != expectedRequest {
+				t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+			}
+
+			if c.expectedError == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if c.expectedError != "" && (err == nil || err.Error() != c.expectedError) {
+				t.Fatalf("expected error %q, got %v", c.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package zendeskapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+// userPayload sends empty tags, which go-zendesk omits, so removing the last tag clears them.
+// The field shadows the one of the embedded user.
+type userPayload struct {
+	zendesk.User
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+func newUserPayload(user zendesk.User) userPayload {
+	payload := userPayload{User: user}
+
+	if user.Tags != nil {
+		payload.Tags = &user.Tags
+	}
+
+	return payload
+}
+
+// UserAPI covers the user endpoints missing from go-zendesk.
+// https://developer.zendesk.com/api-reference/ticketing/users/users/
+type UserAPI interface {
+	CreateUser(ctx context.Context, user zendesk.User) (zendesk.User, error)
+	UpdateUser(ctx context.Context, userID int64, user zendesk.User) (zendesk.User, error)
+	SuspendUser(ctx context.Context, userID int64) error
+	DeleteUser(ctx context.Context, userID int64) error
+}
+
+var _ UserAPI = &Client{}
+
+// CreateUser creates a user, known tags are sent even when empty.
+func (z *Client) CreateUser(ctx context.Context, user zendesk.User) (zendesk.User, error) {
+	var data struct {
+		User userPayload `json:"user"`
+	}
+	var result struct {
+		User zendesk.User `json:"user"`
+	}
+
+	data.User = newUserPayload(user)
+
+	body, err := z.Post(ctx, "/users.json", data)
+	if err != nil {
+		return zendesk.User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return zendesk.User{}, err
+	}
+
+	return result.User, nil
+}
+
+// UpdateUser updates a user, known tags are sent even when empty so they can be cleared.
+func (z *Client) UpdateUser(ctx context.Context, userID int64, user zendesk.User) (zendesk.User, error) {
+	var data struct {
+		User userPayload `json:"user"`
+	}
+	var result struct {
+		User zendesk.User `json:"user"`
+	}
+
+	data.User = newUserPayload(user)
+
+	body, err := z.Put(ctx, fmt.Sprintf("/users/%d.json", userID), data)
+	if err != nil {
+		return zendesk.User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return zendesk.User{}, err
+	}
+
+	return result.User, nil
+}
+
+// SuspendUser suspends a user, only the suspended flag is sent so other attributes are left
+// unchanged.
+func (z *Client) SuspendUser(ctx context.Context, userID int64) error {
+	var data struct {
+		User struct {
+			Suspended bool `json:"suspended"`
+		} `json:"user"`
+	}
+
+	data.User.Suspended = true
+
+	_, err := z.Put(ctx, fmt.Sprintf("/users/%d.json", userID), data)
+
+	return err
+}
+
+// DeleteUser deletes a user, Zendesk keeps deleted users with active set to false until they
+// are permanently deleted.
+func (z *Client) DeleteUser(ctx context.Context, userID int64) error {
+	_, err := z.deleteWithBody(ctx, fmt.Sprintf("/users/%d.json", userID))
+
+	return err
+}
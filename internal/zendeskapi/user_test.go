@@ -0,0 +1,95 @@
+package zendeskapi
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/zendesk"
+)
+
+func TestUpdateUser(t *testing.T) {
+	cases := []struct {
+		testName     string
+		tags         []string
+		expectedTags string
+	}{
+		{
+			testName:     "should send empty tags to clear them",
+			tags:         []string{},
+			expectedTags: `"tags":[]`,
+		},
+		{
+			testName:     "should send tags",
+			tags:         []string{"tier_2"},
+			expectedTags: `"tags":["tier_2"]`,
+		},
+		{
+			testName: "should omit unknown tags",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			client, received := newTestClient(t, http.StatusOK, `{"user":{"id":1,"name":"Agent"}}`)
+
+			user, err := client.UpdateUser(t.Context(), 1, zendesk.User{Name: "Agent", Tags: c.tags})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if received.method != http.MethodPut || received.path != "/api/v2/users/1.json" {
+				t.Fatalf("unexpected request %+v", *received)
+			}
+
+			if c.expectedTags == "" {
+				if strings.Contains(received.body, `"tags"`) {
+					t.Fatalf("expected tags to be omitted, got %s", received.body)
+				}
+			} else if strings.Count(received.body, `"tags"`) != 1 || !strings.Contains(received.body, c.expectedTags) {
+				t.Fatalf("expected %s, got %s", c.expectedTags, received.body)
+			}
+
+			if user.ID != 1 {
+				t.Fatalf("unexpected user %+v", user)
+			}
+		})
+	}
+}
+
+func TestSuspendUser(t *testing.T) {
+	client, received := newTestClient(t, http.StatusOK, `{"user":{"id":1,"suspended":true}}`)
+
+	if err := client.SuspendUser(t.Context(), 1); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPut,
+		path:   "/api/v2/users/1.json",
+		body:   `{"user":{"suspended":true}}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	client, received := newTestClient(t, http.StatusOK, `{"user":{"id":1,"active":false}}`)
+
+	if err := client.DeleteUser(t.Context(), 1); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if received.method != http.MethodDelete || received.path != "/api/v2/users/1.json" {
+		t.Fatalf("unexpected request %+v", *received)
+	}
+}
+
+func TestDeleteUser_NotFound(t *testing.T) {
+	client, _ := newTestClient(t, http.StatusNotFound, `{"error":"RecordNotFound"}`)
+
+	if err := client.DeleteUser(t.Context(), 1); err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -0,0 +1,33 @@
+package zendeskapi
+
+import (
+	"context"
+)
+
+// ViewPosition is the position of a view in a bulk update.
+type ViewPosition struct {
+	ID       int64 `json:"id"`
+	Position int64 `json:"position"`
+}
+
+type ViewAPI interface {
+	UpdateViewPositions(ctx context.Context, ids []int64) error
+}
+
+var _ ViewAPI = &Client{}
+
+// UpdateViewPositions sets the position of every view from its index in ids, in one bulk update.
+func (z *Client) UpdateViewPositions(ctx context.Context, ids []int64) error {
+	var data struct {
+		Views []ViewPosition `json:"views"`
+	}
+
+	data.Views = make([]ViewPosition, 0, len(ids))
+	for position, id := range ids {
+		data.Views = append(data.Views, ViewPosition{ID: id, Position: int64(position)})
+	}
+
+	_, err := z.Put(ctx, "/views/update_many.json", data)
+
+	return err
+}
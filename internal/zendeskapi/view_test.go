@@ -0,0 +1,23 @@
+package zendeskapi
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestUpdateViewPositions(t *testing.T) {
+	client, received := newTestClient(t, http.StatusOK, `{"views":[{"id":2,"position":0},{"id":1,"position":1}]}`)
+
+	if err := client.UpdateViewPositions(t.Context(), []int64{2, 1}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRequest := testRequest{
+		method: http.MethodPut,
+		path:   "/api/v2/views/update_many.json",
+		body:   `{"views":[{"id":2,"position":0},{"id":1,"position":1}]}`,
+	}
+	if *received != expectedRequest {
+		t.Fatalf("expected request %+v, got %+v", expectedRequest, *received)
+	}
+}
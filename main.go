@@ -7,6 +7,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/devops-wiz/terraform-provider-zendesk/internal/provider"
 
@@ -33,6 +34,15 @@ var (
 )
 
 func main() {
+	// "terraform-provider-zendesk export" generates configuration from an existing account
+	// instead of serving the provider.
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := provider.RunExport(context.Background(), os.Args[2:], os.Stderr); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")